@@ -2,6 +2,7 @@ package common
 
 import (
 	"encoding/json"
+	"sort"
 	"strings"
 
 	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
@@ -49,3 +50,40 @@ func ExtractParams(params []v1alpha1.ParameterValue, raw runtime.RawExtension) (
 	}
 	return ExtractFromMap(params, values), nil
 }
+
+// ParamReferences returns the keys of the parameters referenced with the "[fromParam(key)]" pattern in
+// the values of raw, map keys in sorted order.
+func ParamReferences(raw runtime.RawExtension) ([]string, error) {
+	if len(raw.Raw) == 0 {
+		return nil, nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(raw.Raw, &v); err != nil {
+		return nil, err
+	}
+	var keys []string
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch val := v.(type) {
+		case string:
+			if match, key := matchPattern(val); match {
+				keys = append(keys, key)
+			}
+		case map[string]interface{}:
+			names := make([]string, 0, len(val))
+			for k := range val {
+				names = append(names, k)
+			}
+			sort.Strings(names)
+			for _, k := range names {
+				walk(val[k])
+			}
+		case []interface{}:
+			for _, e := range val {
+				walk(e)
+			}
+		}
+	}
+	walk(v)
+	return keys, nil
+}
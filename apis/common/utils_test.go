@@ -177,3 +177,16 @@ func TestExtractParams(t *testing.T) {
 		assert.Equal(t, ti.expValues, gotValue)
 	}
 }
+
+func TestParamReferences(t *testing.T) {
+	keys, err := ParamReferences(runtime.RawExtension{Raw: []byte(`{"b":"[fromParam(k2)]","a":{"c":["[fromParam(k1)]",1]},"d":"v"}`)})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"k1", "k2"}, keys)
+
+	keys, err = ParamReferences(runtime.RawExtension{})
+	assert.NoError(t, err)
+	assert.Nil(t, keys)
+
+	_, err = ParamReferences(runtime.RawExtension{Raw: []byte(`{`)})
+	assert.Error(t, err)
+}
@@ -76,8 +76,54 @@ type ApplicationConfigurationStatus struct {
 	// The generation observed by the ApplicationConfiguration controller.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Components not deployed yet because they wait for their dependencies.
+	// +optional
+	BlockedComponents []BlockedComponent `json:"blockedComponents,omitempty"`
+
+	// The history revision of the applied spec.
+	// +optional
+	CurrentRevision int64 `json:"currentRevision,omitempty"`
+
+	// Rollout progress of components with the rollout trait, by component name.
+	// +optional
+	Rollouts map[string]RolloutStatus `json:"rollouts,omitempty"`
+}
+
+// RolloutStatus is the progress of the rollout of a component
+type RolloutStatus struct {
+	// Phase of the rollout. Values: Progressing, Waiting, Paused, Completed, Aborted
+	Phase string `json:"phase,omitempty"`
+	// Current step and number of steps, the canary is the first step if any.
+	Step  int32 `json:"step,omitempty"`
+	Steps int32 `json:"steps,omitempty"`
+	// Updated replicas and replicas expected to be updated at the current step.
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+	TargetReplicas  int32 `json:"targetReplicas,omitempty"`
+	// A human readable message on the progress.
+	Message string `json:"message,omitempty"`
+}
+
+// BlockedComponent is a component waiting for its dependencies
+type BlockedComponent struct {
+	// ComponentName of the blocked component
+	ComponentName string `json:"componentName"`
+	// The reason the component is blocked.
+	Reason string `json:"reason,omitempty"`
+	// A human readable message indicating what the component waits for.
+	Message string `json:"message,omitempty"`
 }
 
+// Reasons of a BlockedComponent
+const (
+	// DependencyNotReady means a component the component depends on is not ready.
+	DependencyNotReady = "DependencyNotReady"
+	// DependencyBlocked means a component the component depends on is blocked itself.
+	DependencyBlocked = "DependencyBlocked"
+	// ParameterPending means a parameter value taken from another component is not available yet.
+	ParameterPending = "ParameterPending"
+)
+
 // ApplicationPhase is a label for the condition of a Application at the current time.
 type ApplicationPhase string
 
@@ -99,17 +145,41 @@ const (
 	ApplicationFailed ApplicationPhase = "Failed"
 )
 
+// Labels set on objects produced for an ApplicationConfiguration, so they can be tracked back
+// to the component they belong to.
+const (
+	// LabelApplicationConfiguration is the name of the ApplicationConfiguration an object is produced for.
+	LabelApplicationConfiguration = Group + Separator + "app"
+	// LabelComponent is the ComponentName of the component an object is produced for.
+	LabelComponent = Group + Separator + "component"
+)
+
 // ModuleStatus is a generic status holder for components
 // +k8s:deepcopy-gen=true
 type ModuleStatus struct {
 	// NamespacedName of component
 	NamespacedName string `json:"name,omitempty"`
+	// Component this module is produced for, taken from the LabelComponent label
+	// +optional
+	Component string `json:"component,omitempty"`
 	// Kind of component
 	Kind string `json:"kind,omitempty"`
 	// ComponentConfiguration groupVersion
 	GroupVersion string `json:"groupVersion,omitempty"`
 	// Status. Values: Progressing, Ready, Failed
 	Status string `json:"status,omitempty"`
+	// Reason in CamelCase of the status
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message about the status for humans
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Ready replicas of a workload
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// Desired replicas of a workload
+	// +optional
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -149,6 +219,11 @@ const (
 // +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:shortName=appconfig,categories=oam
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // ApplicationConfiguration is the Schema for the operationalconfigurations API
 type ApplicationConfiguration struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -184,6 +259,12 @@ type ComponentConfiguration struct {
 	Traits []TraitBinding `json:"traits,omitempty"`
 	// +optional
 	ApplicationScopes []string `json:"applicationScopes,omitempty"`
+	// TODO this is extension field, names of the components that must be ready before this one is deployed
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+	// TODO this is extension field, pins the component to a ComponentSchematic revision instead of its latest spec
+	// +optional
+	RevisionName string `json:"revisionName,omitempty"`
 }
 
 type TraitBinding struct {
@@ -205,6 +286,75 @@ func (c *ComponentConfiguration) ExistTrait(t string) bool {
 	return name != ""
 }
 
+// ComponentNames returns the names of the ComponentSchematics referenced by the application.
+func (s *ApplicationConfigurationSpec) ComponentNames() []string {
+	return s.names(func(c *ComponentConfiguration) []string { return []string{c.ComponentName} })
+}
+
+// TraitNames returns the names of the Traits bound to components of the application.
+func (s *ApplicationConfigurationSpec) TraitNames() []string {
+	return s.names(func(c *ComponentConfiguration) []string {
+		var names []string
+		for _, t := range c.Traits {
+			names = append(names, t.Name)
+		}
+		return names
+	})
+}
+
+// ScopeNames returns the names of the ApplicationScopes components of the application are in.
+func (s *ApplicationConfigurationSpec) ScopeNames() []string {
+	return s.names(func(c *ComponentConfiguration) []string { return c.ApplicationScopes })
+}
+
+// names returns the distinct non empty names returned by f for components.
+func (s *ApplicationConfigurationSpec) names(f func(c *ComponentConfiguration) []string) []string {
+	var names []string
+	seen := map[string]bool{}
+	for i := range s.Components {
+		for _, n := range f(&s.Components[i]) {
+			if n != "" && !seen[n] {
+				seen[n] = true
+				names = append(names, n)
+			}
+		}
+	}
+	return names
+}
+
+// Dependencies returns names of the components this component depends on, either explicitly
+// through DependsOn, by taking parameter values from them or through its traits, see RegisterTraitDependencies.
+func (c *ComponentConfiguration) Dependencies() []string {
+	deps := append([]string{}, c.DependsOn...)
+	for _, d := range append(c.ParameterDependencies(), c.TraitDependencies()...) {
+		exists := false
+		for _, e := range deps {
+			if e == d {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			deps = append(deps, d)
+		}
+	}
+	return deps
+}
+
+// ParameterDependencies returns names of the components this component takes parameter values from.
+func (c *ComponentConfiguration) ParameterDependencies() []string {
+	var deps []string
+	seen := map[string]bool{}
+	for _, p := range c.ParameterValues {
+		if p.From == nil || p.From.Component == "" || seen[p.From.Component] {
+			continue
+		}
+		seen[p.From.Component] = true
+		deps = append(deps, p.From.Component)
+	}
+	return deps
+}
+
 // Get specific trait's Full name of this component and its parameterValues.
 // If not exist, name is "" and parameterValues is nil.
 // bool mark whether this trait has ref name.
@@ -20,6 +20,7 @@ type ParameterFrom struct {
 /**
 Translate []ParameterValue to struct, the struct always should be empty struct pointer.
 e.g: Translate(&RollOutParameter{}, p)
+Values coming from other components (From) should be resolved before translating.
 */
 func Translate(v interface{}, p []ParameterValue) error {
 	props := make(map[string]string)
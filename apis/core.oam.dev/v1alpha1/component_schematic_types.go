@@ -266,6 +266,11 @@ type ComponentStatus struct {
 }
 
 // +genclient
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=comp,categories=oam
+// +kubebuilder:printcolumn:name="Workload-Type",type="string",JSONPath=".spec.workloadType"
+// +kubebuilder:printcolumn:name="Revision",type="string",JSONPath=".status.latestReadyComponentRevisionName"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // ComponentSchematic is the Schema for the components API
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
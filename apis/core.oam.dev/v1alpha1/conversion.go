@@ -0,0 +1,5 @@
+package v1alpha1
+
+// Hub marks ApplicationConfiguration as the conversion hub, the version other versions are converted to
+// and stored as.
+func (*ApplicationConfiguration) Hub() {}
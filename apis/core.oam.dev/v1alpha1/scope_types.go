@@ -12,6 +12,10 @@ type ApplicationScopeStatus struct {
 }
 
 // +genclient
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=scope,categories=oam
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type ApplicationScope struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -1,25 +1,77 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/oam-dev/oam-go-sdk/apis/flags"
 	"github.com/oam-dev/oam-go-sdk/apis/handlers"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/api/extensions/v1beta1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
 	policyv1 "k8s.io/api/policy/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 )
 
 // Update component status with specific status and meta info.
 func (s *ModuleStatus) Update(rsrc metav1.Object, status string) {
+	s.UpdateResult(rsrc, handlers.StatusResult{Status: status})
+}
+
+// UpdateResult updates component status with a detailed status result and meta info.
+func (s *ModuleStatus) UpdateResult(rsrc metav1.Object, result handlers.StatusResult) {
 	ro := rsrc.(runtime.Object)
 	gvk := ro.GetObjectKind().GroupVersionKind()
 	s.NamespacedName = rsrc.GetNamespace() + string(types.Separator) + rsrc.GetName()
+	s.Component = rsrc.GetLabels()[LabelComponent]
 	s.GroupVersion = gvk.GroupVersion().String()
 	s.Kind = gvk.GroupKind().Kind
-	s.Status = status
+	s.Status = result.Status
+	s.Reason = result.Reason
+	s.Message = result.Message
+	s.ReadyReplicas = result.ReadyReplicas
+	s.DesiredReplicas = result.DesiredReplicas
+}
+
+// Summary describes the status of the module for humans.
+func (s *ModuleStatus) Summary() string {
+	summary := s.Kind + " " + s.NamespacedName + " " + s.Status
+	if s.DesiredReplicas > 0 {
+		summary += fmt.Sprintf(" (%d/%d ready)", s.ReadyReplicas, s.DesiredReplicas)
+	}
+	switch {
+	case s.Message != "":
+		summary += ": " + s.Message
+	case s.Reason != "":
+		summary += ": " + s.Reason
+	}
+	return summary
+}
+
+// ComponentReady returns true if modules produced for component are all ready.
+// A component without any module is not ready.
+func (m *ApplicationConfigurationStatus) ComponentReady(component string) bool {
+	found := false
+	for _, os := range m.Modules {
+		if os.Component != component {
+			continue
+		}
+		if os.Status != flags.StatusReady {
+			return false
+		}
+		found = true
+	}
+	return found
 }
 
 // ResetComponentList - reset component list objects
@@ -34,92 +86,252 @@ func (m *ApplicationConfigurationStatus) Update(rsrcs []metav1.Object, err error
 	// compute components status
 	for _, r := range rsrcs {
 		os := ModuleStatus{}
-		os.Update(r, flags.StatusReady)
-		switch r.(type) {
-		case *appsv1.StatefulSet:
-			os.Status = stsStatus(r.(*appsv1.StatefulSet))
-		case *policyv1.PodDisruptionBudget:
-			os.Status = pdbStatus(r.(*policyv1.PodDisruptionBudget))
-		case *appsv1.Deployment:
-			os.Status = deploymentStatus(r.(*appsv1.Deployment))
-		case *appsv1.ReplicaSet:
-			os.Status = replicasetStatus(r.(*appsv1.ReplicaSet))
-		case *appsv1.DaemonSet:
-			os.Status = daemonsetStatus(r.(*appsv1.DaemonSet))
-		case *corev1.Pod:
-			os.Status = podStatus(r.(*corev1.Pod))
-		case *corev1.Service:
-			os.Status = serviceStatus(r.(*corev1.Service))
-		case *corev1.PersistentVolumeClaim:
-			os.Status = pvcStatus(r.(*corev1.PersistentVolumeClaim))
-		case *v1beta1.Ingress:
-			os.Status = ingressStatus(r.(*v1beta1.Ingress))
-		default:
-			os.Status = handlers.TryStatusHandler(r)
-		}
+		os.UpdateResult(r, EvaluateStatus(r))
 		m.Modules = append(m.Modules, os)
 	}
 
 	// aggregate
+	var notReady, failed []string
 	if len(m.Modules) == 0 {
 		ready = false
 	}
 	for _, os := range m.Modules {
 		if os.Status != flags.StatusReady {
 			ready = false
+			notReady = append(notReady, os.Summary())
+		}
+		if os.Status == flags.StatusFailed {
+			failed = append(failed, os.Summary())
 		}
 	}
-	if ready {
+	if len(failed) > 0 {
+		m.Phase = ApplicationFailed
+		m.NotReady("ComponentsFailed", strings.Join(failed, "; "))
+	} else if ready {
 		m.Phase = ApplicationReady
 		m.Ready("ComponentsReady", "all components ready")
+	} else if len(m.Modules) == 0 {
+		m.Phase = ApplicationProgressing
+		m.NotReady("ComponentsNotReady", "no components")
 	} else {
 		m.Phase = ApplicationProgressing
-		m.NotReady("ComponentsNotReady", "some components not ready")
+		m.NotReady("ComponentsNotReady", fmt.Sprintf("%d/%d components ready: %s",
+			len(m.Modules)-len(notReady), len(m.Modules), strings.Join(notReady, "; ")))
 	}
 	if err != nil {
 		m.SetConditionTrue(Error, "ErrorSeen", err.Error())
 	}
 }
 
+// ResourceStatus evaluates status of a k8s build-in object, other objects are evaluated by
+// the status handlers registered for their GVK.
+func ResourceStatus(r metav1.Object) string {
+	return EvaluateStatus(r).Status
+}
+
+// EvaluateStatus evaluates detailed status of a k8s build-in object, other objects are evaluated by
+// the status handlers registered for their GVK, then from their fields by handlers.EvaluateUnstructured.
+// Handlers registered for Job, CronJob, HorizontalPodAutoscaler, ConfigMap and Secret take precedence
+// over their build-in evaluation. Unstructured build-in objects are converted to their typed object first.
+func EvaluateStatus(r metav1.Object) handlers.StatusResult {
+	if u, ok := r.(*unstructured.Unstructured); ok {
+		if typed, ok := handlers.ToTyped(u); ok {
+			r = typed
+		}
+	}
+	if result, ok := typedStatus(r); ok {
+		return result
+	}
+
+	result, registered := handlers.LookupStatusResultHandler(r)
+	if registered {
+		if result.Status != flags.StatusUnknown {
+			return result
+		}
+	} else if builtin, ok := builtinStatus(r); ok {
+		return builtin
+	}
+	if u := toUnstructured(r); u != nil {
+		if generic, ok := handlers.EvaluateUnstructured(u); ok {
+			return generic
+		}
+	}
+	return result
+}
+
+// typedStatus evaluates build-in kinds, before the status handlers.
+func typedStatus(r metav1.Object) (handlers.StatusResult, bool) {
+	switch r.(type) {
+	case *appsv1.StatefulSet:
+		return stsStatus(r.(*appsv1.StatefulSet)), true
+	case *policyv1.PodDisruptionBudget:
+		return pdbStatus(r.(*policyv1.PodDisruptionBudget)), true
+	case *appsv1.Deployment:
+		return deploymentStatus(r.(*appsv1.Deployment)), true
+	case *appsv1.ReplicaSet:
+		return replicasetStatus(r.(*appsv1.ReplicaSet)), true
+	case *appsv1.DaemonSet:
+		return daemonsetStatus(r.(*appsv1.DaemonSet)), true
+	case *corev1.Pod:
+		return podStatus(r.(*corev1.Pod)), true
+	case *corev1.Service:
+		return serviceStatus(r.(*corev1.Service)), true
+	case *corev1.PersistentVolumeClaim:
+		return pvcStatus(r.(*corev1.PersistentVolumeClaim)), true
+	case *v1beta1.Ingress:
+		return ingressStatus(r.(*v1beta1.Ingress).Status.LoadBalancer), true
+	case *networkingv1beta1.Ingress:
+		return ingressStatus(r.(*networkingv1beta1.Ingress).Status.LoadBalancer), true
+	default:
+		return handlers.StatusResult{}, false
+	}
+}
+
+// builtinStatus evaluates build-in kinds which users may evaluate by their own status handlers.
+func builtinStatus(r metav1.Object) (handlers.StatusResult, bool) {
+	switch r.(type) {
+	case *batchv1.Job:
+		return jobStatus(r.(*batchv1.Job)), true
+	case *batchv1beta1.CronJob:
+		return cronjobStatus(r.(*batchv1beta1.CronJob)), true
+	case *autoscalingv1.HorizontalPodAutoscaler:
+		return hpaStatus(r.(*autoscalingv1.HorizontalPodAutoscaler)), true
+	case *autoscalingv2beta2.HorizontalPodAutoscaler:
+		return hpaV2Status(r.(*autoscalingv2beta2.HorizontalPodAutoscaler)), true
+	case *corev1.ConfigMap, *corev1.Secret:
+		// plain data, ready once created
+		return ready(), true
+	default:
+		return handlers.StatusResult{}, false
+	}
+}
+
+func toUnstructured(r metav1.Object) *unstructured.Unstructured {
+	switch o := r.(type) {
+	case *unstructured.Unstructured:
+		return o
+	case runtime.Object:
+		data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return nil
+		}
+		u := &unstructured.Unstructured{Object: data}
+		u.SetGroupVersionKind(o.GetObjectKind().GroupVersionKind())
+		return u
+	default:
+		return nil
+	}
+}
+
+func ready() handlers.StatusResult {
+	return handlers.StatusResult{Status: flags.StatusReady}
+}
+
+func progressing(reason, message string) handlers.StatusResult {
+	return handlers.StatusResult{Status: flags.StatusProgressing, Reason: reason, Message: message}
+}
+
+func failed(reason, message string) handlers.StatusResult {
+	return handlers.StatusResult{Status: flags.StatusFailed, Reason: reason, Message: message}
+}
+
+// withReplicas records ready and desired replicas in result.
+func withReplicas(result handlers.StatusResult, ready, desired int32) handlers.StatusResult {
+	result.ReadyReplicas = ready
+	result.DesiredReplicas = desired
+	return result
+}
+
+func replicas(r *int32) int32 {
+	if r == nil {
+		return 1
+	}
+	return *r
+}
+
 // Resource specific logic -----------------------------------
 
+// generationNotObserved is the status of a workload whose controller didn't see its latest spec yet.
+func generationNotObserved(generation, observed int64) handlers.StatusResult {
+	return progressing("GenerationNotObserved",
+		fmt.Sprintf("waiting for generation %d to be observed, %d observed", generation, observed))
+}
+
 // Statefulset
-func stsStatus(rsrc *appsv1.StatefulSet) string {
-	if rsrc.Status.ReadyReplicas == *rsrc.Spec.Replicas && rsrc.Status.CurrentReplicas == *rsrc.Spec.Replicas {
-		return flags.StatusReady
+func stsStatus(rsrc *appsv1.StatefulSet) handlers.StatusResult {
+	desired := replicas(rsrc.Spec.Replicas)
+	result := ready()
+	// https://github.com/kubernetes/kubectl/blob/release-1.17/pkg/polymorphichelpers/rollout_status.go
+	switch {
+	case rsrc.Status.ObservedGeneration < rsrc.Generation:
+		result = generationNotObserved(rsrc.Generation, rsrc.Status.ObservedGeneration)
+	case rsrc.Status.ReadyReplicas < desired:
+		result = progressing("ReplicasNotReady", fmt.Sprintf("%d/%d replicas ready", rsrc.Status.ReadyReplicas, desired))
+	case rsrc.Spec.UpdateStrategy.Type == appsv1.RollingUpdateStatefulSetStrategyType &&
+		rsrc.Spec.UpdateStrategy.RollingUpdate != nil && rsrc.Spec.UpdateStrategy.RollingUpdate.Partition != nil:
+		// partitioned roll out, only replicas from the partition are updated
+		partition := *rsrc.Spec.UpdateStrategy.RollingUpdate.Partition
+		if rsrc.Status.UpdatedReplicas < desired-partition {
+			result = progressing("RollingUpdate", fmt.Sprintf("%d/%d replicas updated from partition %d",
+				rsrc.Status.UpdatedReplicas, desired-partition, partition))
+		}
+	case rsrc.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType:
+		// replicas are updated once deleted, nothing to wait for
+	case rsrc.Status.UpdateRevision != rsrc.Status.CurrentRevision:
+		result = progressing("RollingUpdate", fmt.Sprintf("%d/%d replicas updated to revision %s",
+			rsrc.Status.UpdatedReplicas, desired, rsrc.Status.UpdateRevision))
 	}
-	return flags.StatusProgressing
+	return withReplicas(result, rsrc.Status.ReadyReplicas, desired)
 }
 
 // Deployment
-func deploymentStatus(rsrc *appsv1.Deployment) string {
-	status := flags.StatusProgressing
-	progress := true
-	available := true
+func deploymentStatus(rsrc *appsv1.Deployment) handlers.StatusResult {
+	desired := replicas(rsrc.Spec.Replicas)
+	result := ready()
+	var deadlineExceeded *appsv1.DeploymentCondition
+	for i, c := range rsrc.Status.Conditions {
+		if c.Type == appsv1.DeploymentProgressing && c.Reason == "ProgressDeadlineExceeded" {
+			deadlineExceeded = &rsrc.Status.Conditions[i]
+		}
+	}
+	// https://github.com/kubernetes/kubectl/blob/release-1.17/pkg/polymorphichelpers/rollout_status.go
+	switch {
+	case rsrc.Status.ObservedGeneration < rsrc.Generation:
+		result = generationNotObserved(rsrc.Generation, rsrc.Status.ObservedGeneration)
+	case deadlineExceeded != nil:
+		result = failed(deadlineExceeded.Reason, deadlineExceeded.Message)
+	case rsrc.Status.UpdatedReplicas < desired:
+		result = progressing("RollingUpdate", fmt.Sprintf("%d/%d replicas updated", rsrc.Status.UpdatedReplicas, desired))
+	case rsrc.Status.Replicas > rsrc.Status.UpdatedReplicas:
+		result = progressing("RollingUpdate", fmt.Sprintf("%d old replicas pending termination",
+			rsrc.Status.Replicas-rsrc.Status.UpdatedReplicas))
+	case rsrc.Status.AvailableReplicas < rsrc.Status.UpdatedReplicas:
+		result = progressing("ReplicasNotAvailable", fmt.Sprintf("%d/%d updated replicas available",
+			rsrc.Status.AvailableReplicas, rsrc.Status.UpdatedReplicas))
+	}
 	for _, c := range rsrc.Status.Conditions {
+		if result.Status != flags.StatusReady {
+			break
+		}
 		switch c.Type {
 		case appsv1.DeploymentProgressing:
 			// https://github.com/kubernetes/kubernetes/blob/a3ccea9d8743f2ff82e41b6c2af6dc2c41dc7b10/pkg/controller/deployment/progress.go#L52
 			if c.Status != corev1.ConditionTrue || c.Reason != "NewReplicaSetAvailable" {
-				progress = false
+				result = progressing(c.Reason, c.Message)
 			}
 		case appsv1.DeploymentAvailable:
 			if c.Status == corev1.ConditionFalse {
-				available = false
+				result = progressing(c.Reason, c.Message)
 			}
 		}
 	}
-
-	if progress && available {
-		status = flags.StatusReady
-	}
-
-	return status
+	return withReplicas(result, rsrc.Status.ReadyReplicas, desired)
 }
 
 // Replicaset
-func replicasetStatus(rsrc *appsv1.ReplicaSet) string {
-	status := flags.StatusProgressing
+func replicasetStatus(rsrc *appsv1.ReplicaSet) handlers.StatusResult {
+	result := progressing("ReplicasNotReady", fmt.Sprintf("%d/%d replicas ready, %d available",
+		rsrc.Status.ReadyReplicas, rsrc.Status.Replicas, rsrc.Status.AvailableReplicas))
 	failure := false
 	for _, c := range rsrc.Status.Conditions {
 		switch c.Type {
@@ -127,76 +339,203 @@ func replicasetStatus(rsrc *appsv1.ReplicaSet) string {
 		case appsv1.ReplicaSetReplicaFailure:
 			if c.Status == corev1.ConditionTrue {
 				failure = true
+				result = progressing(c.Reason, c.Message)
 				break
 			}
 		}
 	}
 
 	if !failure && rsrc.Status.ReadyReplicas == rsrc.Status.Replicas && rsrc.Status.Replicas == rsrc.Status.AvailableReplicas {
-		status = flags.StatusReady
+		result = ready()
 	}
 
-	return status
+	return withReplicas(result, rsrc.Status.ReadyReplicas, replicas(rsrc.Spec.Replicas))
 }
 
 // Daemonset
-func daemonsetStatus(rsrc *appsv1.DaemonSet) string {
-	status := flags.StatusProgressing
-	if rsrc.Status.DesiredNumberScheduled == rsrc.Status.NumberAvailable && rsrc.Status.DesiredNumberScheduled == rsrc.Status.NumberReady {
-		status = flags.StatusReady
+func daemonsetStatus(rsrc *appsv1.DaemonSet) handlers.StatusResult {
+	desired := rsrc.Status.DesiredNumberScheduled
+	result := ready()
+	switch {
+	case rsrc.Status.ObservedGeneration < rsrc.Generation:
+		result = generationNotObserved(rsrc.Generation, rsrc.Status.ObservedGeneration)
+	case rsrc.Spec.UpdateStrategy.Type != appsv1.OnDeleteDaemonSetStrategyType && rsrc.Status.UpdatedNumberScheduled < desired:
+		result = progressing("RollingUpdate", fmt.Sprintf("%d/%d pods updated", rsrc.Status.UpdatedNumberScheduled, desired))
+	case rsrc.Status.NumberAvailable < desired || rsrc.Status.NumberReady < desired:
+		result = progressing("PodsNotReady", fmt.Sprintf("%d/%d pods ready, %d available",
+			rsrc.Status.NumberReady, desired, rsrc.Status.NumberAvailable))
 	}
-	return status
+	return withReplicas(result, rsrc.Status.NumberReady, desired)
+}
+
+// Job
+func jobStatus(rsrc *batchv1.Job) handlers.StatusResult {
+	completions := replicas(rsrc.Spec.Completions)
+	for _, c := range rsrc.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			return withReplicas(ready(), rsrc.Status.Succeeded, completions)
+		case batchv1.JobFailed:
+			return withReplicas(failed(c.Reason, c.Message), rsrc.Status.Succeeded, completions)
+		}
+	}
+	result := progressing("Running", fmt.Sprintf("%d/%d completions, %d active, %d failed",
+		rsrc.Status.Succeeded, completions, rsrc.Status.Active, rsrc.Status.Failed))
+	if rsrc.Status.Failed == 0 && rsrc.Status.Succeeded >= completions {
+		result = ready()
+	}
+	return withReplicas(result, rsrc.Status.Succeeded, completions)
+}
+
+// CronJob, ready once scheduled, the jobs it creates have their own status
+func cronjobStatus(rsrc *batchv1beta1.CronJob) handlers.StatusResult {
+	return ready()
+}
+
+// HorizontalPodAutoscaler
+func hpaStatus(rsrc *autoscalingv1.HorizontalPodAutoscaler) handlers.StatusResult {
+	result := ready()
+	if rsrc.Status.ObservedGeneration != nil && *rsrc.Status.ObservedGeneration < rsrc.Generation {
+		result = generationNotObserved(rsrc.Generation, *rsrc.Status.ObservedGeneration)
+	} else if rsrc.Status.ObservedGeneration == nil {
+		result = progressing("NotObserved", "waiting for the autoscaler to observe its target")
+	}
+	return withReplicas(result, rsrc.Status.CurrentReplicas, rsrc.Status.DesiredReplicas)
+}
+
+// HorizontalPodAutoscaler v2beta2, with conditions
+func hpaV2Status(rsrc *autoscalingv2beta2.HorizontalPodAutoscaler) handlers.StatusResult {
+	result := ready()
+	switch {
+	case rsrc.Status.ObservedGeneration == nil:
+		result = progressing("NotObserved", "waiting for the autoscaler to observe its target")
+	case *rsrc.Status.ObservedGeneration < rsrc.Generation:
+		result = generationNotObserved(rsrc.Generation, *rsrc.Status.ObservedGeneration)
+	default:
+		for _, c := range rsrc.Status.Conditions {
+			if c.Type == autoscalingv2beta2.AbleToScale && c.Status == corev1.ConditionFalse {
+				result = progressing(c.Reason, c.Message)
+			}
+		}
+	}
+	return withReplicas(result, rsrc.Status.CurrentReplicas, rsrc.Status.DesiredReplicas)
 }
 
 // PVC
-func pvcStatus(rsrc *corev1.PersistentVolumeClaim) string {
-	status := flags.StatusProgressing
+func pvcStatus(rsrc *corev1.PersistentVolumeClaim) handlers.StatusResult {
 	if rsrc.Status.Phase == corev1.ClaimBound {
-		status = flags.StatusReady
+		return ready()
 	}
-	return status
+	return progressing("NotBound", fmt.Sprintf("claim is %s", rsrc.Status.Phase))
 }
 
 // Service
-func serviceStatus(rsrc *corev1.Service) string {
-	status := flags.StatusReady
+func serviceStatus(rsrc *corev1.Service) handlers.StatusResult {
 	if rsrc.Spec.Type == corev1.ServiceTypeLoadBalancer {
 		// For LoadBalancer, we need to wait ingress bind
 		if len(rsrc.Status.LoadBalancer.Ingress) == 0 {
 			// if no bind
-			status = flags.StatusProgressing
+			return progressing("LoadBalancerPending", "waiting for load balancer")
 		}
 	}
-	return status
+	return ready()
 }
 
 // Ingress
-func ingressStatus(rsrc *v1beta1.Ingress) string {
-	status := flags.StatusReady
-	if len(rsrc.Status.LoadBalancer.Ingress) == 0 {
+func ingressStatus(lb corev1.LoadBalancerStatus) handlers.StatusResult {
+	if len(lb.Ingress) == 0 {
 		// if no bind
-		status = flags.StatusProgressing
+		return progressing("LoadBalancerPending", "waiting for load balancer")
+	}
+	return ready()
+}
+
+// Ingress of networking.k8s.io/v1, not part of the k8s api the SDK is built with, only evaluated
+// from unstructured objects.
+func unstructuredIngressStatus(r metav1.Object) (handlers.StatusResult, bool) {
+	u, ok := r.(*unstructured.Unstructured)
+	if !ok {
+		return handlers.StatusResult{}, false
+	}
+	lb, _, _ := unstructured.NestedSlice(u.Object, "status", "loadBalancer", "ingress")
+	if len(lb) == 0 {
+		return progressing("LoadBalancerPending", "waiting for load balancer"), true
+	}
+	return ready(), true
+}
+
+// builtinScheme has the groups of the build-in kinds evaluated by EvaluateStatus, unstructured objects of
+// these groups are converted to their typed object.
+var builtinScheme = runtime.NewScheme()
+
+func init() {
+	handlers.RegisterStatusResultHandler(schema.GroupVersionKind{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"},
+		unstructuredIngressStatus)
+
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		appsv1.AddToScheme, autoscalingv1.AddToScheme, autoscalingv2beta2.AddToScheme, batchv1.AddToScheme,
+		batchv1beta1.AddToScheme, corev1.AddToScheme, v1beta1.AddToScheme, networkingv1beta1.AddToScheme,
+		policyv1.AddToScheme,
+	} {
+		utilruntime.Must(addToScheme(builtinScheme))
 	}
-	return status
+	handlers.RegisterTypedStatus(builtinScheme, func(r metav1.Object) (handlers.StatusResult, bool) {
+		if result, ok := typedStatus(r); ok {
+			return result, true
+		}
+		return builtinStatus(r)
+	})
+}
+
+// podFailureReasons are reasons of waiting containers which won't start without a change.
+var podFailureReasons = map[string]bool{
+	"CrashLoopBackOff":           true,
+	"ImagePullBackOff":           true,
+	"ErrImagePull":               true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
 }
 
 // Pod
-func podStatus(rsrc *corev1.Pod) string {
-	status := flags.StatusProgressing
+func podStatus(rsrc *corev1.Pod) handlers.StatusResult {
+	if rsrc.Status.Phase == corev1.PodFailed {
+		reason := rsrc.Status.Reason
+		if reason == "" {
+			reason = "PodFailed"
+		}
+		return failed(reason, rsrc.Status.Message)
+	}
+	var statuses []corev1.ContainerStatus
+	statuses = append(statuses, rsrc.Status.InitContainerStatuses...)
+	statuses = append(statuses, rsrc.Status.ContainerStatuses...)
+	for _, cs := range statuses {
+		if w := cs.State.Waiting; w != nil && podFailureReasons[w.Reason] {
+			return failed(w.Reason, fmt.Sprintf("container %s: %s", cs.Name, w.Message))
+		}
+	}
+	result := progressing(string(rsrc.Status.Phase), rsrc.Status.Message)
 	for i := range rsrc.Status.Conditions {
-		if rsrc.Status.Conditions[i].Type == corev1.PodReady &&
-			rsrc.Status.Conditions[i].Status == corev1.ConditionTrue {
-			status = flags.StatusReady
-			break
+		c := rsrc.Status.Conditions[i]
+		if c.Type == corev1.PodReady {
+			if c.Status == corev1.ConditionTrue {
+				result = ready()
+				break
+			}
+			result = progressing(c.Reason, c.Message)
 		}
 	}
-	return status
+	return result
 }
 
 // PodDisruptionBudget
-func pdbStatus(rsrc *policyv1.PodDisruptionBudget) string {
+func pdbStatus(rsrc *policyv1.PodDisruptionBudget) handlers.StatusResult {
+	result := progressing("InsufficientHealthy", fmt.Sprintf("%d/%d pods healthy",
+		rsrc.Status.CurrentHealthy, rsrc.Status.DesiredHealthy))
 	if rsrc.Status.CurrentHealthy >= rsrc.Status.DesiredHealthy {
-		return flags.StatusReady
+		result = ready()
 	}
-	return flags.StatusProgressing
+	return withReplicas(result, rsrc.Status.CurrentHealthy, rsrc.Status.DesiredHealthy)
 }
@@ -11,7 +11,11 @@ import (
 	"github.com/oam-dev/oam-go-sdk/apis/handlers"
 
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestNoModules(t *testing.T) {
@@ -42,3 +46,148 @@ func TestRegisterStatusHandler(t *testing.T) {
 	assert.Equal(t, flags.StatusUnknown, as.Modules[1].Status)
 	assert.Equal(t, flags.StatusProgressing, string(as.Phase))
 }
+
+func TestBuiltinStatus(t *testing.T) {
+	// kinds set, objects of an empty GVK are evaluated by the handler of TestRegisterStatusHandler
+	jb := &v1.Job{TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"}}
+	jb.Status.Succeeded = 1
+	cj := &v1beta1.CronJob{TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1beta1", Kind: "CronJob"}}
+	cm := &corev1.ConfigMap{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"}}
+	secret := &corev1.Secret{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"}}
+	as := new(ApplicationConfigurationStatus)
+	as.Update([]metav1.Object{jb, cj, cm, secret}, nil)
+	assert.Equal(t, ApplicationReady, as.Phase)
+
+	replicas := int32(2)
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Generation: 2},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 1, Replicas: 2, UpdatedReplicas: 2, ReadyReplicas: 2, AvailableReplicas: 2,
+		},
+	}
+	// the change isn't seen by the deployment controller yet
+	assert.Equal(t, "GenerationNotObserved", EvaluateStatus(deploy).Reason)
+	deploy.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 1, ReadyReplicas: 2, AvailableReplicas: 2}
+	assert.Equal(t, "1/2 replicas updated", EvaluateStatus(deploy).Message)
+	deploy.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 2, ReadyReplicas: 2, AvailableReplicas: 2}
+	assert.Equal(t, "1 old replicas pending termination", EvaluateStatus(deploy).Message)
+	deploy.Status = appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, ReadyReplicas: 2, AvailableReplicas: 2}
+	assert.Equal(t, flags.StatusReady, ResourceStatus(deploy))
+
+	// replicas default to 1
+	sts := &appsv1.StatefulSet{Status: appsv1.StatefulSetStatus{ReadyReplicas: 1, CurrentRevision: "a", UpdateRevision: "b"}}
+	assert.Equal(t, "RollingUpdate", EvaluateStatus(sts).Reason)
+	sts.Status.CurrentRevision = "b"
+	assert.Equal(t, flags.StatusReady, ResourceStatus(sts))
+
+	ds := &appsv1.DaemonSet{Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, UpdatedNumberScheduled: 1, NumberReady: 2, NumberAvailable: 2}}
+	assert.Equal(t, "1/2 pods updated", EvaluateStatus(ds).Message)
+
+	ing := &unstructured.Unstructured{}
+	ing.SetAPIVersion("networking.k8s.io/v1")
+	ing.SetKind("Ingress")
+	assert.Equal(t, "LoadBalancerPending", EvaluateStatus(ing).Reason)
+}
+
+func TestUnstructuredBuiltinStatus(t *testing.T) {
+	deploy := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "generation": int64(2)},
+		"spec":       map[string]interface{}{"replicas": int64(2)},
+		"status": map[string]interface{}{
+			"observedGeneration": int64(2), "replicas": int64(2), "updatedReplicas": int64(1),
+			"readyReplicas": int64(2), "availableReplicas": int64(2),
+		},
+	}}
+	assert.Equal(t, "1/2 replicas updated", EvaluateStatus(deploy).Message)
+	result, ok := handlers.EvaluateUnstructured(deploy)
+	assert.True(t, ok)
+	assert.Equal(t, "1/2 replicas updated", result.Message)
+
+	job := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "batch/v1",
+		"kind":       "Job",
+		"metadata":   map[string]interface{}{"name": "migrate"},
+		"status":     map[string]interface{}{"succeeded": int64(1)},
+	}}
+	assert.Equal(t, flags.StatusReady, ResourceStatus(job))
+}
+
+func TestStatusResult(t *testing.T) {
+	replicas := int32(3)
+	deploy := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status: appsv1.DeploymentStatus{
+			ReadyReplicas:   1,
+			UpdatedReplicas: 1,
+			Conditions: []appsv1.DeploymentCondition{{
+				Type:    appsv1.DeploymentProgressing,
+				Status:  corev1.ConditionTrue,
+				Reason:  "ReplicaSetUpdated",
+				Message: `ReplicaSet "web-5d8f" is progressing.`,
+			}},
+		},
+	}
+	as := new(ApplicationConfigurationStatus)
+	as.Update([]metav1.Object{deploy}, nil)
+	m := as.Modules[0]
+	assert.Equal(t, flags.StatusProgressing, m.Status)
+	assert.Equal(t, "RollingUpdate", m.Reason)
+	assert.Equal(t, int32(1), m.ReadyReplicas)
+	assert.Equal(t, int32(3), m.DesiredReplicas)
+	assert.Equal(t, `0/1 components ready: Deployment default/web Progressing (1/3 ready): 1/3 replicas updated`,
+		as.GetCondition(Ready).Message)
+}
+
+func TestStatusResultHandlerChain(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"}
+	handlers.RegisterStatusResultHandler(gvk, func(r metav1.Object) (handlers.StatusResult, bool) {
+		return handlers.StatusResult{}, false
+	})
+	handlers.RegisterStatusResultHandler(gvk, func(r metav1.Object) (handlers.StatusResult, bool) {
+		return handlers.StatusResult{Status: flags.StatusProgressing, Reason: "Provisioning"}, true
+	})
+	db := &unstructured.Unstructured{}
+	db.SetGroupVersionKind(gvk)
+	assert.Equal(t, handlers.StatusResult{Status: flags.StatusProgressing, Reason: "Provisioning"}, EvaluateStatus(db))
+}
+
+func TestFailedStatus(t *testing.T) {
+	deploy := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Status: appsv1.DeploymentStatus{
+			Conditions: []appsv1.DeploymentCondition{{
+				Type:    appsv1.DeploymentProgressing,
+				Status:  corev1.ConditionFalse,
+				Reason:  "ProgressDeadlineExceeded",
+				Message: `ReplicaSet "web-5d8f" has timed out progressing.`,
+			}},
+		},
+	}
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "web",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}},
+			}},
+		},
+	}
+	assert.Equal(t, failed("ImagePullBackOff", "container web: Back-off pulling image"), podStatus(pod))
+	jb := &v1.Job{Status: v1.JobStatus{Conditions: []v1.JobCondition{{
+		Type: v1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded",
+	}}}}
+	assert.Equal(t, flags.StatusFailed, jobStatus(jb).Status)
+
+	as := new(ApplicationConfigurationStatus)
+	as.Update([]metav1.Object{deploy, new(corev1.ConfigMap)}, nil)
+	assert.Equal(t, ApplicationFailed, as.Phase)
+	c := as.GetCondition(Ready)
+	assert.Equal(t, "ComponentsFailed", c.Reason)
+	assert.Equal(t, `Deployment default/web Failed (0/1 ready): ReplicaSet "web-5d8f" has timed out progressing.`, c.Message)
+}
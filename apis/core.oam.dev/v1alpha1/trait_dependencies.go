@@ -0,0 +1,29 @@
+package v1alpha1
+
+import "sync"
+
+var traitDependencies = make(map[string]func(t *TraitBinding) []string)
+var traitDependenciesLock sync.Mutex
+
+// RegisterTraitDependencies sets how a component bound to the trait named trait depends on other
+// components through the trait properties, dependencies returns their names. Registering again
+// for trait replaces the function registered before.
+func RegisterTraitDependencies(trait string, dependencies func(t *TraitBinding) []string) {
+	traitDependenciesLock.Lock()
+	defer traitDependenciesLock.Unlock()
+	traitDependencies[trait] = dependencies
+}
+
+// TraitDependencies returns names of the components this component depends on through its traits.
+func (c *ComponentConfiguration) TraitDependencies() []string {
+	var deps []string
+	for i := range c.Traits {
+		traitDependenciesLock.Lock()
+		dependencies := traitDependencies[c.Traits[i].Name]
+		traitDependenciesLock.Unlock()
+		if dependencies != nil {
+			deps = append(deps, dependencies(&c.Traits[i])...)
+		}
+	}
+	return deps
+}
@@ -42,6 +42,9 @@ type TraitStatus struct {
 
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=trait,categories=oam
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // Trait is the Schema for the traits API
 type Trait struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -54,7 +54,12 @@ type WorkloadTypeStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
 }
 
+// +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=workload,categories=oam
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.names.kind"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // WorkloadType is the Schema for the workloadtypes API
 type WorkloadType struct {
@@ -148,6 +148,18 @@ func (in *ApplicationConfigurationStatus) DeepCopyInto(out *ApplicationConfigura
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.BlockedComponents != nil {
+		in, out := &in.BlockedComponents, &out.BlockedComponents
+		*out = make([]BlockedComponent, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rollouts != nil {
+		in, out := &in.Rollouts, &out.Rollouts
+		*out = make(map[string]RolloutStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationConfigurationStatus.
@@ -254,6 +266,21 @@ func (in *ApplicationScopeStatus) DeepCopy() *ApplicationScopeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockedComponent) DeepCopyInto(out *BlockedComponent) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockedComponent.
+func (in *BlockedComponent) DeepCopy() *BlockedComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockedComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPU) DeepCopyInto(out *CPU) {
 	*out = *in
@@ -292,6 +319,11 @@ func (in *ComponentConfiguration) DeepCopyInto(out *ComponentConfiguration) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentConfiguration.
@@ -764,6 +796,21 @@ func (in *Resources) DeepCopy() *Resources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScopeBinding) DeepCopyInto(out *ScopeBinding) {
 	*out = *in
@@ -0,0 +1,245 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+)
+
+// AnnotationV1alpha1Spec keeps the variables and scope bindings of a v1alpha1 ApplicationConfiguration,
+// which have no v1alpha2 equivalent, so they are not lost when it is converted back.
+const AnnotationV1alpha1Spec = "core.oam.dev/v1alpha1-spec"
+
+// AnnotationV1alpha2Scopes keeps the scopes of v1alpha2 components other than ApplicationScopes, which
+// v1alpha1 components can't be in, by component instance name, so they are not lost when it is converted back.
+const AnnotationV1alpha2Scopes = "core.oam.dev/v1alpha2-scopes"
+
+// TraitBindingKind is the kind of the trait objects v1alpha1 trait bindings are converted to.
+const TraitBindingKind = "TraitBinding"
+
+// applicationScopeKind is the kind of the scopes v1alpha1 components can be in.
+const applicationScopeKind = "ApplicationScope"
+
+// v1alpha1Spec is the part of a v1alpha1 spec kept in AnnotationV1alpha1Spec.
+type v1alpha1Spec struct {
+	Variables []v1alpha1.Variable     `json:"variables,omitempty"`
+	Scopes    []v1alpha1.ScopeBinding `json:"scopes,omitempty"`
+}
+
+// traitBinding is a v1alpha1 trait binding as a v1alpha2 trait object.
+type traitBinding struct {
+	metav1.TypeMeta `json:",inline"`
+	v1alpha1.TraitBinding
+}
+
+var _ conversion.Convertible = &ApplicationConfiguration{}
+
+// ConvertApplicationConfiguration converts a v1alpha1 ApplicationConfiguration, see ConvertFrom.
+func ConvertApplicationConfiguration(in *v1alpha1.ApplicationConfiguration) (*ApplicationConfiguration, error) {
+	out := &ApplicationConfiguration{}
+	if err := out.ConvertFrom(in); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConvertTo converts ac to the v1alpha1 ApplicationConfiguration dst, the storage version. Scopes of
+// kinds other than ApplicationScope are kept in the AnnotationV1alpha2Scopes annotation.
+func (ac *ApplicationConfiguration) ConvertTo(dst conversion.Hub) error {
+	out, ok := dst.(*v1alpha1.ApplicationConfiguration)
+	if !ok {
+		return fmt.Errorf("unsupported conversion of ApplicationConfiguration to %T", dst)
+	}
+	out.ObjectMeta = *ac.ObjectMeta.DeepCopy()
+	out.Spec = v1alpha1.ApplicationConfigurationSpec{}
+	if s, ok := out.Annotations[AnnotationV1alpha1Spec]; ok {
+		kept := &v1alpha1Spec{}
+		if err := json.Unmarshal([]byte(s), kept); err != nil {
+			return fmt.Errorf("invalid %s annotation: %v", AnnotationV1alpha1Spec, err)
+		}
+		out.Spec.Variables, out.Spec.Scopes = kept.Variables, kept.Scopes
+		delete(out.Annotations, AnnotationV1alpha1Spec)
+		if len(out.Annotations) == 0 {
+			out.Annotations = nil
+		}
+	}
+	scopes := map[string][]ComponentScope{}
+	out.Spec.Components = make([]v1alpha1.ComponentConfiguration, 0, len(ac.Spec.Components))
+	for _, c := range ac.Spec.Components {
+		comp := v1alpha1.ComponentConfiguration{
+			ComponentName: c.ComponentName,
+			InstanceName:  c.InstanceName,
+			RefName:       c.RefName,
+			RevisionName:  c.RevisionName,
+			DependsOn:     c.DependsOn,
+		}
+		for _, v := range c.ParameterValues {
+			pv := v1alpha1.ParameterValue{Name: v.Name, Value: v.Value.String()}
+			if v.From != nil {
+				pv.From = &v1alpha1.ParameterFrom{Component: v.From.Component, FieldPath: v.From.FieldPath}
+			}
+			comp.ParameterValues = append(comp.ParameterValues, pv)
+		}
+		for _, t := range c.Traits {
+			binding, err := toTraitBinding(t.Trait)
+			if err != nil {
+				return fmt.Errorf("component %s: %v", c.ComponentName, err)
+			}
+			comp.Traits = append(comp.Traits, binding)
+		}
+		for _, s := range c.Scopes {
+			ref := s.ScopeReference
+			if ref.APIVersion != v1alpha1.SchemeGroupVersion.String() || ref.Kind != applicationScopeKind {
+				key := scopesKey(c.InstanceName, c.ComponentName)
+				scopes[key] = append(scopes[key], s)
+				continue
+			}
+			comp.ApplicationScopes = append(comp.ApplicationScopes, ref.Name)
+		}
+		out.Spec.Components = append(out.Spec.Components, comp)
+	}
+	if len(scopes) > 0 {
+		kept, err := json.Marshal(scopes)
+		if err != nil {
+			return err
+		}
+		if out.Annotations == nil {
+			out.Annotations = map[string]string{}
+		}
+		out.Annotations[AnnotationV1alpha2Scopes] = string(kept)
+	}
+	ac.Status.DeepCopyInto(&out.Status)
+	return nil
+}
+
+// scopesKey returns the key of the scopes of a component in AnnotationV1alpha2Scopes, its instance name
+// or its component name if it has none.
+func scopesKey(instanceName, componentName string) string {
+	if instanceName != "" {
+		return instanceName
+	}
+	return componentName
+}
+
+// ConvertFrom converts the v1alpha1 ApplicationConfiguration src to ac. Trait bindings become objects of
+// kind TraitBindingKind, unless they hold a v1alpha2 trait, application scopes are referenced by kind and
+// scopes kept in the AnnotationV1alpha2Scopes annotation are restored.
+func (ac *ApplicationConfiguration) ConvertFrom(src conversion.Hub) error {
+	in, ok := src.(*v1alpha1.ApplicationConfiguration)
+	if !ok {
+		return fmt.Errorf("unsupported conversion of ApplicationConfiguration from %T", src)
+	}
+	ac.ObjectMeta = *in.ObjectMeta.DeepCopy()
+	scopes := map[string][]ComponentScope{}
+	if s, ok := ac.Annotations[AnnotationV1alpha2Scopes]; ok {
+		if err := json.Unmarshal([]byte(s), &scopes); err != nil {
+			return fmt.Errorf("invalid %s annotation: %v", AnnotationV1alpha2Scopes, err)
+		}
+		delete(ac.Annotations, AnnotationV1alpha2Scopes)
+		if len(ac.Annotations) == 0 {
+			ac.Annotations = nil
+		}
+	}
+	if len(in.Spec.Variables) > 0 || len(in.Spec.Scopes) > 0 {
+		kept, err := json.Marshal(v1alpha1Spec{Variables: in.Spec.Variables, Scopes: in.Spec.Scopes})
+		if err != nil {
+			return err
+		}
+		if ac.Annotations == nil {
+			ac.Annotations = map[string]string{}
+		}
+		ac.Annotations[AnnotationV1alpha1Spec] = string(kept)
+	}
+	ac.Spec = ApplicationConfigurationSpec{Components: make([]ApplicationConfigurationComponent, 0, len(in.Spec.Components))}
+	for _, c := range in.Spec.Components {
+		comp := ApplicationConfigurationComponent{
+			ComponentName: c.ComponentName,
+			InstanceName:  c.InstanceName,
+			RefName:       c.RefName,
+			RevisionName:  c.RevisionName,
+			DependsOn:     c.DependsOn,
+		}
+		for _, v := range c.ParameterValues {
+			pv := ComponentParameterValue{Name: v.Name, Value: intstr.FromString(v.Value)}
+			if v.From != nil {
+				pv.From = &ParameterFrom{Component: v.From.Component, FieldPath: v.From.FieldPath}
+			}
+			comp.ParameterValues = append(comp.ParameterValues, pv)
+		}
+		for _, t := range c.Traits {
+			raw, err := fromTraitBinding(t)
+			if err != nil {
+				return fmt.Errorf("component %s: trait %s: %v", c.ComponentName, t.Name, err)
+			}
+			comp.Traits = append(comp.Traits, ComponentTrait{Trait: raw})
+		}
+		for _, name := range c.ApplicationScopes {
+			comp.Scopes = append(comp.Scopes, ComponentScope{ScopeReference: TypedReference{
+				APIVersion: v1alpha1.SchemeGroupVersion.String(),
+				Kind:       applicationScopeKind,
+				Name:       name,
+			}})
+		}
+		comp.Scopes = append(comp.Scopes, scopes[scopesKey(c.InstanceName, c.ComponentName)]...)
+		ac.Spec.Components = append(ac.Spec.Components, comp)
+	}
+	in.Status.DeepCopyInto(&ac.Status)
+	return nil
+}
+
+// fromTraitBinding returns the trait object of binding b: its properties if they are the v1alpha2 trait
+// object of kind b.Name, or b itself as a TraitBindingKind object.
+func fromTraitBinding(b v1alpha1.TraitBinding) (runtime.RawExtension, error) {
+	if len(b.Properties.Raw) > 0 {
+		tm := &metav1.TypeMeta{}
+		if err := json.Unmarshal(b.Properties.Raw, tm); err == nil && tm.APIVersion != "" && tm.Kind == b.Name {
+			return runtime.RawExtension{Raw: b.Properties.Raw}, nil
+		}
+	}
+	raw, err := json.Marshal(traitBinding{
+		TypeMeta:     metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: TraitBindingKind},
+		TraitBinding: b,
+	})
+	return runtime.RawExtension{Raw: raw}, err
+}
+
+// toTraitBinding is the inverse of fromTraitBinding, trait objects other than TraitBindingKind ones are
+// kept as properties of a binding named after their kind.
+func toTraitBinding(trait runtime.RawExtension) (v1alpha1.TraitBinding, error) {
+	tm := &metav1.TypeMeta{}
+	if err := json.Unmarshal(trait.Raw, tm); err != nil {
+		return v1alpha1.TraitBinding{}, fmt.Errorf("invalid trait: %v", err)
+	}
+	if tm.APIVersion == v1alpha1.SchemeGroupVersion.String() && tm.Kind == TraitBindingKind {
+		b := &traitBinding{}
+		if err := json.Unmarshal(trait.Raw, b); err != nil {
+			return v1alpha1.TraitBinding{}, fmt.Errorf("invalid %s: %v", TraitBindingKind, err)
+		}
+		return b.TraitBinding, nil
+	}
+	if tm.Kind == "" {
+		return v1alpha1.TraitBinding{}, fmt.Errorf("trait has no kind")
+	}
+	return v1alpha1.TraitBinding{Name: tm.Kind, Properties: runtime.RawExtension{Raw: trait.Raw}}, nil
+}
@@ -0,0 +1,316 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+)
+
+// AnnotationWorkloadType records on a workload converted from a ComponentSchematic its v1alpha1 workload type.
+const AnnotationWorkloadType = "core.oam.dev/v1alpha1-workload-type"
+
+// ContainerizedWorkloadDefinition is the name of the definition of ContainerizedWorkloads.
+const ContainerizedWorkloadDefinition = "containerizedworkloads." + Group
+
+// ParseWorkloadType splits a v1alpha1 workload, trait or scope type such as core.oam.dev/v1alpha1.Server
+// into its apiVersion and kind.
+func ParseWorkloadType(t string) (apiVersion, kind string, err error) {
+	slash := strings.Index(t, "/")
+	dot := strings.Index(t[slash+1:], ".")
+	if slash <= 0 || dot <= 0 || slash+dot+2 == len(t) {
+		return "", "", fmt.Errorf("invalid type %q, <group>/<version>.<kind> expected", t)
+	}
+	return t[:slash+dot+1], t[slash+dot+2:], nil
+}
+
+// isCore returns whether apiVersion is the one of the core workload types, run as containers.
+func isCore(apiVersion string) bool {
+	return apiVersion == v1alpha1.SchemeGroupVersion.String()
+}
+
+// definitionName returns the name of the definition of kind in group: its resource name,
+// plural defaults to the lower case kind with an s.
+func definitionName(group, kind, plural string) string {
+	if plural == "" {
+		plural = strings.ToLower(kind) + "s"
+	}
+	if group == "" {
+		return plural
+	}
+	return plural + "." + group
+}
+
+// definitionFor returns the name of the definition of the v1alpha1 type t.
+func definitionFor(t string) (string, error) {
+	apiVersion, kind, err := ParseWorkloadType(t)
+	if err != nil {
+		return "", err
+	}
+	if isCore(apiVersion) {
+		return ContainerizedWorkloadDefinition, nil
+	}
+	return definitionName(strings.Split(apiVersion, "/")[0], kind, ""), nil
+}
+
+// ConvertWorkloadType converts a WorkloadType into a WorkloadDefinition, named after the resource of the workload.
+func ConvertWorkloadType(in *v1alpha1.WorkloadType) *WorkloadDefinition {
+	name := definitionName(in.Spec.Group, in.Spec.Names.Kind, in.Spec.Names.Plural)
+	return &WorkloadDefinition{
+		TypeMeta:   metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: "WorkloadDefinition"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: in.Labels, Annotations: in.Annotations},
+		Spec:       WorkloadDefinitionSpec{Reference: DefinitionReference{Name: name}},
+	}
+}
+
+// ConvertTrait converts a Trait into a TraitDefinition, named after the resource of the trait. The workload
+// types it applies to are converted to workload definition names, "*" to all workloads.
+func ConvertTrait(in *v1alpha1.Trait) (*TraitDefinition, error) {
+	name := definitionName(in.Spec.Group, in.Spec.Names.Kind, in.Spec.Names.Plural)
+	out := &TraitDefinition{
+		TypeMeta:   metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: "TraitDefinition"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: in.Labels, Annotations: in.Annotations},
+		Spec:       TraitDefinitionSpec{Reference: DefinitionReference{Name: name}},
+	}
+	seen := map[string]bool{}
+	for _, t := range in.Spec.AppliesTo {
+		if t == "*" {
+			out.Spec.AppliesToWorkloads = nil
+			break
+		}
+		def, err := definitionFor(t)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[def] {
+			seen[def] = true
+			out.Spec.AppliesToWorkloads = append(out.Spec.AppliesToWorkloads, def)
+		}
+	}
+	return out, nil
+}
+
+// ConvertApplicationScope converts an ApplicationScope into the ScopeDefinition of its type.
+func ConvertApplicationScope(in *v1alpha1.ApplicationScope) (*ScopeDefinition, error) {
+	apiVersion, kind, err := ParseWorkloadType(in.Spec.Type)
+	if err != nil {
+		return nil, err
+	}
+	name := definitionName(strings.Split(apiVersion, "/")[0], kind, "")
+	return &ScopeDefinition{
+		TypeMeta:   metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: "ScopeDefinition"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: in.Labels, Annotations: in.Annotations},
+		Spec: ScopeDefinitionSpec{
+			Reference:             DefinitionReference{Name: name},
+			AllowComponentOverlap: in.Spec.AllowComponentOverlap,
+		},
+	}, nil
+}
+
+// ConvertComponentSchematic converts a ComponentSchematic into a Component. The containers of core workload
+// types are inlined into a ContainerizedWorkload, other workloads are objects of their type with the
+// workload settings as spec. Parameters are set at the fields of the env vars and config files reading them,
+// their default value is the value of these fields.
+func ConvertComponentSchematic(in *v1alpha1.ComponentSchematic) (*Component, error) {
+	apiVersion, kind, err := ParseWorkloadType(in.Spec.WorkloadType)
+	if err != nil {
+		return nil, err
+	}
+	out := &Component{
+		TypeMeta: metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: "Component"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        in.Name,
+			Namespace:   in.Namespace,
+			Labels:      in.Labels,
+			Annotations: in.Annotations,
+		},
+		Status: ComponentStatus{ObservedGeneration: in.Status.ObservedGeneration},
+	}
+	if in.Status.LatestReadyComponentRevisionName != "" {
+		out.Status.LatestRevision = &Revision{Name: in.Status.LatestReadyComponentRevisionName}
+	}
+	meta := metav1.ObjectMeta{Name: in.Name, Annotations: map[string]string{AnnotationWorkloadType: in.Spec.WorkloadType}}
+
+	var workload interface{}
+	if isCore(apiVersion) {
+		defaults := map[string]string{}
+		for _, p := range in.Spec.Parameters {
+			if p.Default != "" {
+				defaults[p.Name] = p.Default
+			}
+		}
+		paths := map[string][]string{}
+		w := &ContainerizedWorkload{
+			TypeMeta:   metav1.TypeMeta{APIVersion: SchemeGroupVersion.String(), Kind: "ContainerizedWorkload"},
+			ObjectMeta: meta,
+			Spec: ContainerizedWorkloadSpec{
+				OperatingSystem: in.Spec.OsType,
+				CPUArchitecture: in.Spec.Arch,
+				Containers:      make([]Container, 0, len(in.Spec.Containers)),
+			},
+		}
+		for i, c := range in.Spec.Containers {
+			container, err := convertContainer(c, i, defaults, paths)
+			if err != nil {
+				return nil, fmt.Errorf("container %s: %v", c.Name, err)
+			}
+			w.Spec.Containers = append(w.Spec.Containers, container)
+		}
+		for _, p := range in.Spec.Parameters {
+			out.Spec.Parameters = append(out.Spec.Parameters, ComponentParameter{
+				Name:        p.Name,
+				FieldPaths:  paths[p.Name],
+				Required:    p.Required,
+				Description: p.Description,
+			})
+		}
+		workload = w
+	} else {
+		u := map[string]interface{}{"apiVersion": apiVersion, "kind": kind, "metadata": meta}
+		if len(in.Spec.WorkloadSettings.Raw) > 0 {
+			u["spec"] = json.RawMessage(in.Spec.WorkloadSettings.Raw)
+		}
+		workload = u
+		for _, p := range in.Spec.Parameters {
+			out.Spec.Parameters = append(out.Spec.Parameters, ComponentParameter{
+				Name:        p.Name,
+				Required:    p.Required,
+				Description: p.Description,
+			})
+		}
+	}
+	raw, err := json.Marshal(workload)
+	if err != nil {
+		return nil, err
+	}
+	out.Spec.Workload = runtime.RawExtension{Raw: raw}
+	return out, nil
+}
+
+// convertContainer converts the i-th container c, the field paths of parameters it reads are added to paths.
+func convertContainer(c v1alpha1.Container, i int, defaults map[string]string, paths map[string][]string) (Container, error) {
+	out := Container{
+		Name:            c.Name,
+		Image:           c.Image,
+		Command:         c.Cmd,
+		Arguments:       c.Args,
+		LivenessProbe:   convertProbe(c.LivenessProbe),
+		ReadinessProbe:  convertProbe(c.ReadinessProbe),
+		StartupProbe:    convertProbe(c.StartupProbe),
+		ImagePullSecret: c.ImagePullSecret,
+	}
+	resources, err := convertResources(c.Resources)
+	if err != nil {
+		return out, err
+	}
+	out.Resources = resources
+	value := func(v, param, path string) *string {
+		if param != "" {
+			paths[param] = append(paths[param], path)
+			if d, ok := defaults[param]; ok {
+				return &d
+			}
+			return nil
+		}
+		return &v
+	}
+	for j, e := range c.Env {
+		out.Environment = append(out.Environment, ContainerEnvVar{
+			Name:  e.Name,
+			Value: value(e.Value, e.FromParam, fmt.Sprintf("spec.containers[%d].env[%d].value", i, j)),
+		})
+	}
+	for j, f := range c.Config {
+		out.ConfigFiles = append(out.ConfigFiles, ContainerConfigFile{
+			Path:  f.Path,
+			Value: value(f.Value, f.FromParam, fmt.Sprintf("spec.containers[%d].config[%d].value", i, j)),
+		})
+	}
+	for _, p := range c.Ports {
+		out.Ports = append(out.Ports, ContainerPort{Name: p.Name, Port: p.ContainerPort, Protocol: string(p.Protocol)})
+	}
+	return out, nil
+}
+
+func convertResources(in v1alpha1.Resources) (*ContainerResources, error) {
+	out := &ContainerResources{}
+	if !in.Cpu.Required.IsZero() {
+		out.CPU = &CPUResources{Required: in.Cpu.Required}
+	}
+	if !in.Memory.Required.IsZero() {
+		out.Memory = &MemoryResources{Required: in.Memory.Required}
+	}
+	if !in.Gpu.Required.IsZero() {
+		out.GPU = &GPUResources{Required: in.Gpu.Required}
+	}
+	for _, v := range in.Volumes {
+		volume := VolumeResource{
+			Name:          v.Name,
+			MountPath:     v.MountPath,
+			AccessMode:    string(v.AccessMode),
+			SharingPolicy: string(v.SharingPolicy),
+		}
+		if v.Disk != nil {
+			q, err := resource.ParseQuantity(v.Disk.Required)
+			if err != nil {
+				return nil, fmt.Errorf("volume %s: invalid disk size %q: %v", v.Name, v.Disk.Required, err)
+			}
+			volume.Disk = &DiskResource{Required: q, Ephemeral: v.Disk.Ephemeral}
+		}
+		out.Volumes = append(out.Volumes, volume)
+	}
+	for _, e := range in.Extended {
+		out.Extended = append(out.Extended, ExtendedResource{Name: e.Name, Required: intstr.Parse(e.Required)})
+	}
+	if out.CPU == nil && out.Memory == nil && out.GPU == nil && out.Volumes == nil && out.Extended == nil {
+		return nil, nil
+	}
+	return out, nil
+}
+
+func convertProbe(in *v1alpha1.HealthProbe) *ContainerHealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := &ContainerHealthProbe{
+		InitialDelaySeconds: in.InitialDelaySeconds,
+		PeriodSeconds:       in.PeriodSeconds,
+		TimeoutSeconds:      in.TimeoutSeconds,
+		SuccessThreshold:    in.SuccessThreshold,
+		FailureThreshold:    in.FailureThreshold,
+	}
+	if in.Exec != nil {
+		out.Exec = &ExecProbe{Command: in.Exec.Command}
+	}
+	if in.HttpGet != nil {
+		out.HTTPGet = &HTTPGetProbe{Path: in.HttpGet.Path, Port: in.HttpGet.Port}
+		for _, h := range in.HttpGet.HttpHeaders {
+			out.HTTPGet.HTTPHeaders = append(out.HTTPGet.HTTPHeaders, HTTPHeader{Name: h.Name, Value: h.Value})
+		}
+	}
+	if in.TcpSocket != nil {
+		out.TCPSocket = &TCPSocketProbe{Port: in.TcpSocket.Port}
+	}
+	return out
+}
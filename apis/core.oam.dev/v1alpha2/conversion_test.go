@@ -0,0 +1,194 @@
+package v1alpha2
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+)
+
+func TestParseWorkloadType(t *testing.T) {
+	apiVersion, kind, err := ParseWorkloadType("example.com/v1alpha1.ExtentionWorkload")
+	assert.NoError(t, err)
+	assert.Equal(t, "example.com/v1alpha1", apiVersion)
+	assert.Equal(t, "ExtentionWorkload", kind)
+
+	for _, wt := range []string{"", "Server", "core.oam.dev/v1alpha1", "core.oam.dev/v1alpha1.", "/v1.Server"} {
+		_, _, err := ParseWorkloadType(wt)
+		assert.Error(t, err, wt)
+	}
+}
+
+func TestConvertComponentSchematic(t *testing.T) {
+	in := &v1alpha1.ComponentSchematic{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: v1alpha1.ComponentSpec{
+			WorkloadType: "core.oam.dev/v1alpha1.Server",
+			OsType:       "linux",
+			Parameters: []v1alpha1.Parameter{
+				{Name: "message", Required: true, Description: "greeting"},
+				{Name: "port", Default: "8080"},
+			},
+			Containers: []v1alpha1.Container{{
+				Name:  "web",
+				Image: "nginx",
+				Resources: v1alpha1.Resources{
+					Cpu:     v1alpha1.CPU{Required: resource.MustParse("0.5")},
+					Volumes: []v1alpha1.Volume{{Name: "data", MountPath: "/data", Disk: &v1alpha1.Disk{Required: "1Gi"}}},
+				},
+				Env: []v1alpha1.Env{
+					{Name: "MODE", Value: "prod"},
+					{Name: "MESSAGE", FromParam: "message"},
+					{Name: "PORT", FromParam: "port"},
+				},
+				Ports:         []v1alpha1.Port{{Name: "http", ContainerPort: 80}},
+				LivenessProbe: &v1alpha1.HealthProbe{HttpGet: &v1alpha1.HttpGet{Path: "/", Port: 80}},
+			}},
+		},
+		Status: v1alpha1.ComponentStatus{LatestReadyComponentRevisionName: "web-2"},
+	}
+	out, err := ConvertComponentSchematic(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "web", out.Name)
+	assert.Equal(t, &Revision{Name: "web-2"}, out.Status.LatestRevision)
+	assert.Equal(t, []ComponentParameter{
+		{Name: "message", FieldPaths: []string{"spec.containers[0].env[1].value"}, Required: true, Description: "greeting"},
+		{Name: "port", FieldPaths: []string{"spec.containers[0].env[2].value"}},
+	}, out.Spec.Parameters)
+
+	w := &ContainerizedWorkload{}
+	assert.NoError(t, json.Unmarshal(out.Spec.Workload.Raw, w))
+	assert.Equal(t, "ContainerizedWorkload", w.Kind)
+	assert.Equal(t, "core.oam.dev/v1alpha1.Server", w.Annotations[AnnotationWorkloadType])
+	assert.Equal(t, "linux", w.Spec.OperatingSystem)
+	c := w.Spec.Containers[0]
+	assert.Equal(t, "nginx", c.Image)
+	assert.Equal(t, "500m", c.Resources.CPU.Required.String())
+	assert.Nil(t, c.Resources.Memory)
+	assert.Equal(t, "1Gi", c.Resources.Volumes[0].Disk.Required.String())
+	assert.Equal(t, "prod", *c.Environment[0].Value)
+	assert.Nil(t, c.Environment[1].Value)
+	assert.Equal(t, "8080", *c.Environment[2].Value)
+	assert.Equal(t, int32(80), c.Ports[0].Port)
+	assert.Equal(t, "/", c.LivenessProbe.HTTPGet.Path)
+
+	in.Spec.WorkloadType = "example.com/v1alpha1.ExtentionWorkload"
+	in.Spec.WorkloadSettings = runtime.RawExtension{Raw: []byte(`{"replicas":2}`)}
+	out, err = ConvertComponentSchematic(in)
+	assert.NoError(t, err)
+	u := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(out.Spec.Workload.Raw, &u))
+	assert.Equal(t, "example.com/v1alpha1", u["apiVersion"])
+	assert.Equal(t, "ExtentionWorkload", u["kind"])
+	assert.Equal(t, map[string]interface{}{"replicas": float64(2)}, u["spec"])
+
+	in.Spec.WorkloadType = "Server"
+	_, err = ConvertComponentSchematic(in)
+	assert.Error(t, err)
+}
+
+func TestConvertDefinitions(t *testing.T) {
+	wd := ConvertWorkloadType(&v1alpha1.WorkloadType{Spec: v1alpha1.WorkloadTypeSpec{
+		Group: "example.com", Version: "v1alpha1", Names: v1alpha1.Names{Kind: "ExtentionWorkload"},
+	}})
+	assert.Equal(t, "extentionworkloads.example.com", wd.Name)
+	assert.Equal(t, wd.Name, wd.Spec.Reference.Name)
+
+	td, err := ConvertTrait(&v1alpha1.Trait{Spec: v1alpha1.TraitSpec{
+		Group: "core.oam.dev", Names: v1alpha1.Names{Kind: "ManualScaler", Plural: "manualscalertraits"},
+		AppliesTo: []string{"core.oam.dev/v1alpha1.Server", "core.oam.dev/v1alpha1.Worker", "example.com/v1alpha1.ExtentionWorkload"},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "manualscalertraits.core.oam.dev", td.Name)
+	assert.Equal(t, []string{ContainerizedWorkloadDefinition, "extentionworkloads.example.com"}, td.Spec.AppliesToWorkloads)
+
+	td, err = ConvertTrait(&v1alpha1.Trait{Spec: v1alpha1.TraitSpec{Names: v1alpha1.Names{Kind: "Route"}, AppliesTo: []string{"*"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "routes", td.Name)
+	assert.Nil(t, td.Spec.AppliesToWorkloads)
+
+	sd, err := ConvertApplicationScope(&v1alpha1.ApplicationScope{Spec: v1alpha1.ApplicationScopeSpec{
+		Type: "core.oam.dev/v1alpha1.Network", AllowComponentOverlap: true,
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "networks.core.oam.dev", sd.Spec.Reference.Name)
+	assert.True(t, sd.Spec.AllowComponentOverlap)
+}
+
+func TestApplicationConfigurationRoundTrip(t *testing.T) {
+	in := &v1alpha1.ApplicationConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec: v1alpha1.ApplicationConfigurationSpec{
+			Variables: []v1alpha1.Variable{{Name: "v", Value: "1"}},
+			Components: []v1alpha1.ComponentConfiguration{{
+				ComponentName: "web",
+				InstanceName:  "web-1",
+				DependsOn:     []string{"db"},
+				ParameterValues: []v1alpha1.ParameterValue{
+					{Name: "port", Value: "80"},
+					{Name: "host", From: &v1alpha1.ParameterFrom{Component: "db", FieldPath: "status.host"}},
+				},
+				Traits: []v1alpha1.TraitBinding{{
+					Name: "manual-scaler", Properties: runtime.RawExtension{Raw: []byte(`{"replicaCount":2}`)},
+				}},
+				ApplicationScopes: []string{"network"},
+			}},
+		},
+		Status: v1alpha1.ApplicationConfigurationStatus{Phase: v1alpha1.ApplicationReady},
+	}
+	out, err := ConvertApplicationConfiguration(in)
+	assert.NoError(t, err)
+	assert.Contains(t, out.Annotations, AnnotationV1alpha1Spec)
+	assert.Equal(t, v1alpha1.ApplicationReady, out.Status.Phase)
+	comp := out.Spec.Components[0]
+	assert.Equal(t, "80", comp.ParameterValues[0].Value.String())
+	assert.Equal(t, "db", comp.ParameterValues[1].From.Component)
+	assert.Equal(t, TypedReference{APIVersion: "core.oam.dev/v1alpha1", Kind: "ApplicationScope", Name: "network"}, comp.Scopes[0].ScopeReference)
+	tm := &metav1.TypeMeta{}
+	assert.NoError(t, json.Unmarshal(comp.Traits[0].Trait.Raw, tm))
+	assert.Equal(t, TraitBindingKind, tm.Kind)
+
+	back := &v1alpha1.ApplicationConfiguration{}
+	assert.NoError(t, out.ConvertTo(back))
+	assert.Equal(t, in.ObjectMeta, back.ObjectMeta)
+	assert.Equal(t, in.Status, back.Status)
+	assert.Equal(t, in.Spec.Variables, back.Spec.Variables)
+	assert.Equal(t, in.Spec.Components[0].ParameterValues, back.Spec.Components[0].ParameterValues)
+	assert.Equal(t, in.Spec.Components[0].ApplicationScopes, back.Spec.Components[0].ApplicationScopes)
+	assert.Equal(t, in.Spec.Components[0].DependsOn, back.Spec.Components[0].DependsOn)
+	assert.Equal(t, "manual-scaler", back.Spec.Components[0].Traits[0].Name)
+	assert.JSONEq(t, `{"replicaCount":2}`, string(back.Spec.Components[0].Traits[0].Properties.Raw))
+}
+
+func TestApplicationConfigurationV1alpha2Traits(t *testing.T) {
+	trait := `{"apiVersion":"core.oam.dev/v1alpha2","kind":"ManualScalerTrait","spec":{"replicaCount":3}}`
+	in := &ApplicationConfiguration{Spec: ApplicationConfigurationSpec{Components: []ApplicationConfigurationComponent{{
+		ComponentName: "web",
+		Traits:        []ComponentTrait{{Trait: runtime.RawExtension{Raw: []byte(trait)}}},
+	}}}}
+	hub := &v1alpha1.ApplicationConfiguration{}
+	assert.NoError(t, in.ConvertTo(hub))
+	assert.Equal(t, "ManualScalerTrait", hub.Spec.Components[0].Traits[0].Name)
+
+	out := &ApplicationConfiguration{}
+	assert.NoError(t, out.ConvertFrom(hub))
+	assert.JSONEq(t, trait, string(out.Spec.Components[0].Traits[0].Trait.Raw))
+
+	// v1alpha2 scopes are kept in an annotation
+	in.Spec.Components[0].Scopes = []ComponentScope{{ScopeReference: TypedReference{
+		APIVersion: "core.oam.dev/v1alpha2", Kind: "HealthScope", Name: "health",
+	}}}
+	hub = &v1alpha1.ApplicationConfiguration{}
+	assert.NoError(t, in.ConvertTo(hub))
+	assert.Empty(t, hub.Spec.Components[0].ApplicationScopes)
+	assert.Contains(t, hub.Annotations, AnnotationV1alpha2Scopes)
+	out = &ApplicationConfiguration{}
+	assert.NoError(t, out.ConvertFrom(hub))
+	assert.Equal(t, in.Spec.Components[0].Scopes, out.Spec.Components[0].Scopes)
+	assert.Empty(t, out.Annotations)
+}
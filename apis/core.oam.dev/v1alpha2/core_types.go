@@ -0,0 +1,289 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+)
+
+// DefinitionReference references the CustomResourceDefinition a definition is for, by name
+// (e.g. containerizedworkloads.core.oam.dev).
+type DefinitionReference struct {
+	Name string `json:"name"`
+}
+
+// WorkloadDefinitionSpec defines the desired state of WorkloadDefinition
+type WorkloadDefinitionSpec struct {
+	// Reference to the CustomResourceDefinition of the workload
+	Reference DefinitionReference `json:"definitionRef"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories=oam
+// +kubebuilder:printcolumn:name="Definition-Name",type="string",JSONPath=".spec.definitionRef.name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// WorkloadDefinition registers a kind of workload, it replaces the v1alpha1 WorkloadType.
+type WorkloadDefinition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec WorkloadDefinitionSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkloadDefinitionList contains a list of WorkloadDefinition
+type WorkloadDefinitionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkloadDefinition `json:"items"`
+}
+
+// TraitDefinitionSpec defines the desired state of TraitDefinition
+type TraitDefinitionSpec struct {
+	// Reference to the CustomResourceDefinition of the trait
+	Reference DefinitionReference `json:"definitionRef"`
+
+	// The workload definitions this trait applies to, all workloads if empty
+	// +optional
+	AppliesToWorkloads []string `json:"appliesToWorkloads,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories=oam
+// +kubebuilder:printcolumn:name="Definition-Name",type="string",JSONPath=".spec.definitionRef.name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// TraitDefinition registers a kind of trait, it replaces the v1alpha1 Trait.
+type TraitDefinition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TraitDefinitionSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TraitDefinitionList contains a list of TraitDefinition
+type TraitDefinitionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TraitDefinition `json:"items"`
+}
+
+// ScopeDefinitionSpec defines the desired state of ScopeDefinition
+type ScopeDefinitionSpec struct {
+	// Reference to the CustomResourceDefinition of the scope
+	Reference DefinitionReference `json:"definitionRef"`
+
+	// Whether a component may be in several scopes of this kind
+	// +optional
+	AllowComponentOverlap bool `json:"allowComponentOverlap,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories=oam
+// +kubebuilder:printcolumn:name="Definition-Name",type="string",JSONPath=".spec.definitionRef.name"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ScopeDefinition registers a kind of application scope.
+type ScopeDefinition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ScopeDefinitionSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScopeDefinitionList contains a list of ScopeDefinition
+type ScopeDefinitionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScopeDefinition `json:"items"`
+}
+
+// ComponentParameter declares a parameter of a component, its value is set at the given field
+// paths of the workload.
+type ComponentParameter struct {
+	// The parameter's name. Must be unique per component.
+	Name string `json:"name"`
+
+	// Paths of the workload fields the value is set at, e.g. spec.containers[0].env[1].value
+	FieldPaths []string `json:"fieldPaths"`
+
+	// Whether a value must be provided for the parameter.
+	// +optional
+	Required bool `json:"required,omitempty"`
+
+	// A description of the parameter.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// ComponentSpec defines the desired state of Component
+type ComponentSpec struct {
+	// The workload of the component, a kubernetes object such as a ContainerizedWorkload
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Workload runtime.RawExtension `json:"workload"`
+
+	// +optional
+	Parameters []ComponentParameter `json:"parameters,omitempty"`
+}
+
+// Revision is a revision of a component.
+type Revision struct {
+	Name string `json:"name"`
+}
+
+// ComponentStatus defines the observed state of Component
+type ComponentStatus struct {
+	// The latest revision of the component
+	// +optional
+	LatestRevision *Revision `json:"latestRevision,omitempty"`
+
+	// The generation observed by the component controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=oam
+// +kubebuilder:printcolumn:name="Workload-Kind",type="string",JSONPath=".spec.workload.kind"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Component is a workload with its parameters, it replaces the v1alpha1 ComponentSchematic.
+type Component struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ComponentSpec `json:"spec"`
+	// +optional
+	Status ComponentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ComponentList contains a list of Component
+type ComponentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Component `json:"items"`
+}
+
+// ComponentParameterValue is a value of a component parameter.
+type ComponentParameterValue struct {
+	Name string `json:"name"`
+	// +optional
+	Value intstr.IntOrString `json:"value,omitempty"`
+	// TODO this is extension field, reads the value from a field of another component
+	// +optional
+	From *ParameterFrom `json:"from,omitempty"`
+}
+
+// ParameterFrom references a field of another component of the application.
+type ParameterFrom struct {
+	Component string `json:"component,omitempty"`
+	FieldPath string `json:"fieldPath,omitempty"`
+}
+
+// ComponentTrait is a trait of a component, a kubernetes object such as a ManualScalerTrait.
+type ComponentTrait struct {
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Trait runtime.RawExtension `json:"trait"`
+}
+
+// TypedReference references an object by apiVersion, kind and name.
+type TypedReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+}
+
+// ComponentScope is a scope a component is in.
+type ComponentScope struct {
+	ScopeReference TypedReference `json:"scopeRef"`
+}
+
+// ApplicationConfigurationComponent is a component of an application with its parameter values,
+// traits and scopes.
+type ApplicationConfigurationComponent struct {
+	ComponentName string `json:"componentName"`
+	// TODO this is extension field, the v1alpha1 instance name of the workload
+	// +optional
+	InstanceName string `json:"instanceName,omitempty"`
+	// TODO this is extension field, the v1alpha1 workload reference name
+	// +optional
+	RefName string `json:"refName,omitempty"`
+	// TODO this is extension field, pins the component to a revision instead of its latest spec
+	// +optional
+	RevisionName string `json:"revisionName,omitempty"`
+	// +optional
+	ParameterValues []ComponentParameterValue `json:"parameterValues,omitempty"`
+	// +optional
+	Traits []ComponentTrait `json:"traits,omitempty"`
+	// +optional
+	Scopes []ComponentScope `json:"scopes,omitempty"`
+	// TODO this is extension field, names of the components that must be ready before this one is deployed
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// ApplicationConfigurationSpec defines the desired state of ApplicationConfiguration
+type ApplicationConfigurationSpec struct {
+	Components []ApplicationConfigurationComponent `json:"components"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=appconfig,categories=oam
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ApplicationConfiguration is the Schema for the applicationconfigurations API. Its status is the
+// v1alpha1 status, both versions are reconciled the same way.
+type ApplicationConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ApplicationConfigurationSpec `json:"spec,omitempty"`
+	// +optional
+	Status v1alpha1.ApplicationConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationConfigurationList contains a list of ApplicationConfiguration
+type ApplicationConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ApplicationConfiguration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&WorkloadDefinition{}, &WorkloadDefinitionList{},
+		&TraitDefinition{}, &TraitDefinitionList{},
+		&ScopeDefinition{}, &ScopeDefinitionList{},
+		&Component{}, &ComponentList{},
+		&ApplicationConfiguration{}, &ApplicationConfigurationList{})
+}
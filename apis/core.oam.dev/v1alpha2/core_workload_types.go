@@ -0,0 +1,216 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// CPUResources is the minimum number of logical cpus required by a container.
+type CPUResources struct {
+	Required resource.Quantity `json:"required"`
+}
+
+// MemoryResources is the minimum amount of memory required by a container.
+type MemoryResources struct {
+	Required resource.Quantity `json:"required"`
+}
+
+// GPUResources is the minimum number of gpus required by a container.
+type GPUResources struct {
+	Required resource.Quantity `json:"required"`
+}
+
+// DiskResource describes the disk backing a volume.
+type DiskResource struct {
+	Required resource.Quantity `json:"required"`
+	// +optional
+	Ephemeral bool `json:"ephemeral,omitempty"`
+}
+
+// VolumeResource is a path attached to a container and its requirements.
+type VolumeResource struct {
+	Name      string `json:"name"`
+	MountPath string `json:"mountPath"`
+	// RW or RO
+	// +optional
+	AccessMode string `json:"accessMode,omitempty"`
+	// Shared or Exclusive
+	// +optional
+	SharingPolicy string `json:"sharingPolicy,omitempty"`
+	// +optional
+	Disk *DiskResource `json:"disk,omitempty"`
+}
+
+// ExtendedResource is a resource not covered by the other resources of a container.
+type ExtendedResource struct {
+	Name     string             `json:"name"`
+	Required intstr.IntOrString `json:"required"`
+}
+
+// ContainerResources defines the resources required by a container.
+type ContainerResources struct {
+	// +optional
+	CPU *CPUResources `json:"cpu,omitempty"`
+	// +optional
+	Memory *MemoryResources `json:"memory,omitempty"`
+	// +optional
+	GPU *GPUResources `json:"gpu,omitempty"`
+	// +optional
+	Volumes []VolumeResource `json:"volumes,omitempty"`
+	// +optional
+	Extended []ExtendedResource `json:"extended,omitempty"`
+}
+
+// SecretKeySelector selects a key of a Secret.
+type SecretKeySelector struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// ContainerEnvVar is an environment variable of a container.
+type ContainerEnvVar struct {
+	Name string `json:"name"`
+	// +optional
+	Value *string `json:"value,omitempty"`
+	// +optional
+	FromSecret *SecretKeySelector `json:"fromSecret,omitempty"`
+}
+
+// ContainerConfigFile is a file written in a container.
+type ContainerConfigFile struct {
+	Path string `json:"path"`
+	// +optional
+	Value *string `json:"value,omitempty"`
+	// +optional
+	FromSecret *SecretKeySelector `json:"fromSecret,omitempty"`
+}
+
+// ContainerPort is a port exposed by a container.
+type ContainerPort struct {
+	Name string `json:"name"`
+	Port int32  `json:"containerPort"`
+	// TCP or UDP
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+}
+
+// ExecProbe probes a container by running a command in it.
+type ExecProbe struct {
+	Command []string `json:"command"`
+}
+
+// HTTPHeader is a header sent by a HTTPGetProbe.
+type HTTPHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// HTTPGetProbe probes a container by sending it a GET request.
+type HTTPGetProbe struct {
+	Path string `json:"path"`
+	Port int32  `json:"port"`
+	// +optional
+	HTTPHeaders []HTTPHeader `json:"httpHeaders,omitempty"`
+}
+
+// TCPSocketProbe probes a container by opening a socket to it.
+type TCPSocketProbe struct {
+	Port int32 `json:"port"`
+}
+
+// ContainerHealthProbe checks the health of a container.
+type ContainerHealthProbe struct {
+	// +optional
+	Exec *ExecProbe `json:"exec,omitempty"`
+	// +optional
+	HTTPGet *HTTPGetProbe `json:"httpGet,omitempty"`
+	// +optional
+	TCPSocket *TCPSocketProbe `json:"tcpSocket,omitempty"`
+	// +optional
+	InitialDelaySeconds int32 `json:"initialDelaySeconds,omitempty"`
+	// +optional
+	PeriodSeconds int32 `json:"periodSeconds,omitempty"`
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+	// +optional
+	SuccessThreshold int32 `json:"successThreshold,omitempty"`
+	// +optional
+	FailureThreshold int32 `json:"failureThreshold,omitempty"`
+}
+
+// Container describes a container of a ContainerizedWorkload.
+type Container struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+	// +optional
+	Resources *ContainerResources `json:"resources,omitempty"`
+	// +optional
+	Command []string `json:"command,omitempty"`
+	// +optional
+	Arguments []string `json:"args,omitempty"`
+	// +optional
+	Environment []ContainerEnvVar `json:"env,omitempty"`
+	// +optional
+	ConfigFiles []ContainerConfigFile `json:"config,omitempty"`
+	// +optional
+	Ports []ContainerPort `json:"ports,omitempty"`
+	// +optional
+	LivenessProbe *ContainerHealthProbe `json:"livenessProbe,omitempty"`
+	// +optional
+	ReadinessProbe *ContainerHealthProbe `json:"readinessProbe,omitempty"`
+	// TODO this is extension field, not part of the spec
+	// +optional
+	StartupProbe *ContainerHealthProbe `json:"startupProbe,omitempty"`
+	// +optional
+	ImagePullSecret string `json:"imagePullSecret,omitempty"`
+}
+
+// ContainerizedWorkloadSpec defines the desired state of ContainerizedWorkload
+type ContainerizedWorkloadSpec struct {
+	// +optional
+	OperatingSystem string `json:"osType,omitempty"`
+	// +optional
+	CPUArchitecture string      `json:"arch,omitempty"`
+	Containers      []Container `json:"containers"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=oam
+
+// ContainerizedWorkload is the core workload of the specification, a set of containers. The
+// containers of v1alpha1 ComponentSchematics are inlined into one.
+type ContainerizedWorkload struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ContainerizedWorkloadSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ContainerizedWorkloadList contains a list of ContainerizedWorkload
+type ContainerizedWorkloadList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ContainerizedWorkload `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ContainerizedWorkload{}, &ContainerizedWorkloadList{})
+}
@@ -0,0 +1,150 @@
+package fieldpath
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Get returns the value found at fieldPath in obj.
+// fieldPath is a JSONPath expression as used by kubectl, e.g: "{.status.podIP}", the braces
+// and leading dot can be omitted: ".status.podIP" and "status.podIP" are equivalent.
+// Found is false if the field doesn't exist (yet), non scalar values are returned in json format.
+// Paths of field names and array indexes are walked in obj, other expressions are evaluated by jsonpath
+// with missing keys allowed.
+func Get(obj interface{}, fieldPath string) (value string, found bool, err error) {
+	data, err := toUnstructured(obj)
+	if err != nil {
+		return "", false, err
+	}
+
+	var v interface{}
+	if fields, ok := simplePath(fieldPath); ok {
+		v, found = walk(data, fields)
+	} else {
+		v, found, err = find(data, fieldPath)
+		if err != nil {
+			return "", false, err
+		}
+	}
+	if !found || v == nil {
+		return "", false, nil
+	}
+	switch iv := v.(type) {
+	case string:
+		return iv, true, nil
+	default:
+		buf := new(bytes.Buffer)
+		if err := json.NewEncoder(buf).Encode(iv); err != nil {
+			return "", false, err
+		}
+		return strings.TrimSpace(buf.String()), true, nil
+	}
+}
+
+// simplePathRE matches paths made of field names and array indexes, e.g: ".spec.ports[0].port".
+var simplePathRE = regexp.MustCompile(`^(\.[A-Za-z0-9_-]+(\[[0-9]+\])*)+$`)
+
+// simplePath splits fieldPath in field names and array indexes if it has nothing else, ok is false for
+// other JSONPath expressions.
+func simplePath(fieldPath string) (fields []interface{}, ok bool) {
+	p := normalize(fieldPath)
+	if !strings.HasSuffix(p, "}") {
+		return nil, false
+	}
+	p = p[1 : len(p)-1]
+	if !simplePathRE.MatchString(p) {
+		return nil, false
+	}
+	for _, name := range strings.Split(p[1:], ".") {
+		index := strings.IndexByte(name, '[')
+		if index < 0 {
+			fields = append(fields, name)
+			continue
+		}
+		fields = append(fields, name[:index])
+		for _, i := range strings.Split(strings.TrimSuffix(name[index+1:], "]"), "][") {
+			n, err := strconv.Atoi(i)
+			if err != nil {
+				return nil, false
+			}
+			fields = append(fields, n)
+		}
+	}
+	return fields, true
+}
+
+// walk returns the value at fields of data, found is false if a field or an index doesn't exist.
+func walk(data interface{}, fields []interface{}) (value interface{}, found bool) {
+	value = data
+	for _, field := range fields {
+		switch field := field.(type) {
+		case int:
+			values, ok := value.([]interface{})
+			if !ok || field >= len(values) {
+				return nil, false
+			}
+			value = values[field]
+		case string:
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if value, found, _ = unstructured.NestedFieldNoCopy(m, field); !found {
+				return nil, false
+			}
+		}
+	}
+	return value, true
+}
+
+// find evaluates the JSONPath expression fieldPath on data, missing keys are not found.
+func find(data interface{}, fieldPath string) (value interface{}, found bool, err error) {
+	jp := jsonpath.New("fieldPath").AllowMissingKeys(true)
+	if err := jp.Parse(normalize(fieldPath)); err != nil {
+		return nil, false, fmt.Errorf("invalid field path %q: %v", fieldPath, err)
+	}
+	results, err := jp.FindResults(data)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(results) == 0 || len(results[0]) == 0 {
+		return nil, false, nil
+	}
+	v := results[0][0]
+	if !v.CanInterface() {
+		return nil, false, nil
+	}
+	return v.Interface(), true, nil
+}
+
+func normalize(fieldPath string) string {
+	p := strings.TrimSpace(fieldPath)
+	if strings.HasPrefix(p, "{") {
+		return p
+	}
+	if !strings.HasPrefix(p, ".") {
+		p = "." + p
+	}
+	return "{" + p + "}"
+}
+
+func toUnstructured(obj interface{}) (interface{}, error) {
+	switch o := obj.(type) {
+	case runtime.Unstructured:
+		return o.UnstructuredContent(), nil
+	case map[string]interface{}:
+		return o, nil
+	case runtime.Object:
+		return runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	default:
+		return nil, fmt.Errorf("unsupported object type %T", obj)
+	}
+}
@@ -0,0 +1,46 @@
+package fieldpath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGet(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "db"},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: "10.0.0.1",
+			Ports:     []corev1.ServicePort{{Name: "mysql", Port: 3306}},
+		},
+	}
+	tests := []struct {
+		path     string
+		expValue string
+		expFound bool
+	}{
+		{path: "{.spec.clusterIP}", expValue: "10.0.0.1", expFound: true},
+		{path: ".spec.clusterIP", expValue: "10.0.0.1", expFound: true},
+		{path: "spec.clusterIP", expValue: "10.0.0.1", expFound: true},
+		{path: "spec.ports[0].port", expValue: "3306", expFound: true},
+		{path: "spec.ports[1].port", expValue: "", expFound: false},
+		{path: "status.loadBalancer.ingress[0].ip", expValue: "", expFound: false},
+		{path: "spec.externalName", expValue: "", expFound: false},
+		{path: "spec.clusterIP.ip", expValue: "", expFound: false},
+		{path: "spec.ports[0]", expValue: `{"name":"mysql","port":3306,"targetPort":0}`, expFound: true},
+		{path: `{.spec.ports[?(@.name=="mysql")].port}`, expValue: "3306", expFound: true},
+		{path: `{.spec.ports[?(@.name=="mysql")].nodePort}`, expValue: "", expFound: false},
+		{path: `{.status.loadBalancer.ingress[*].ip}`, expValue: "", expFound: false},
+	}
+	for _, ti := range tests {
+		value, found, err := Get(svc, ti.path)
+		assert.NoError(t, err, ti.path)
+		assert.Equal(t, ti.expFound, found, ti.path)
+		assert.Equal(t, ti.expValue, value, ti.path)
+	}
+
+	_, _, err := Get(svc, "{.spec[")
+	assert.Error(t, err)
+}
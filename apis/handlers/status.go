@@ -11,29 +11,79 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+// StatusResult is the status of an object, with why it is in this status.
+type StatusResult struct {
+	// Status. Values: Progressing, Ready, Failed, Unknown
+	Status string
+	// Reason in CamelCase of the status, empty for a ready object
+	Reason string
+	// Message for humans
+	Message string
+	// Ready and desired replicas of workloads, 0 for other objects
+	ReadyReplicas   int32
+	DesiredReplicas int32
+}
+
 type StatusHander func(rsrc metav1.Object) string
 
+// StatusResultHandler evaluates status of an object, ok is false if the handler can't tell,
+// the next handler of the chain is tried then.
+type StatusResultHandler func(rsrc metav1.Object) (result StatusResult, ok bool)
+
 var statusHandlers = make(map[string]StatusHander)
+var statusResultHandlers = make(map[string][]StatusResultHandler)
 var statusHandlerLock sync.Mutex
 
 func FormatGVK(gvk schema.GroupVersionKind) string {
 	return fmt.Sprintf("%s/%s.%s", gvk.Group, gvk.Version, gvk.Kind)
 }
 
+// RegisterStatusHandler sets the status handler of gvk, replacing the one registered before. It is tried
+// before the chain of result handlers, an Unknown status passes on to the chain.
 func RegisterStatusHandler(gvk schema.GroupVersionKind, handler StatusHander) {
 	statusHandlerLock.Lock()
 	defer statusHandlerLock.Unlock()
 	statusHandlers[FormatGVK(gvk)] = handler
 }
 
+// RegisterStatusResultHandler adds handler to the chain of gvk, handlers are tried in registration order.
+func RegisterStatusResultHandler(gvk schema.GroupVersionKind, handler StatusResultHandler) {
+	statusHandlerLock.Lock()
+	defer statusHandlerLock.Unlock()
+	key := FormatGVK(gvk)
+	statusResultHandlers[key] = append(statusResultHandlers[key], handler)
+}
+
 func TryStatusHandler(r metav1.Object) string {
+	return TryStatusResultHandler(r).Status
+}
+
+// TryStatusResultHandler evaluates status of r with the status handler of its GVK then its chain of
+// result handlers, the status is Unknown if no handler can tell.
+func TryStatusResultHandler(r metav1.Object) StatusResult {
+	result, _ := LookupStatusResultHandler(r)
+	return result
+}
+
+// LookupStatusResultHandler is like TryStatusResultHandler, registered is false if no status handler
+// nor result handler is registered for the GVK of r.
+func LookupStatusResultHandler(r metav1.Object) (result StatusResult, registered bool) {
 	if ro, ok := r.(runtime.Object); ok {
+		key := FormatGVK(ro.GetObjectKind().GroupVersionKind())
 		statusHandlerLock.Lock()
-		handler, ok := statusHandlers[FormatGVK(ro.GetObjectKind().GroupVersionKind())]
+		handler, chain := statusHandlers[key], statusResultHandlers[key]
 		statusHandlerLock.Unlock()
-		if ok {
-			return handler(r)
+		registered = handler != nil || len(chain) > 0
+		if handler != nil {
+			if status := handler(r); status != flags.StatusUnknown {
+				return StatusResult{Status: status}, true
+			}
+		}
+		for _, handler := range chain {
+			if result, ok := handler(r); ok {
+				return result, true
+			}
 		}
 	}
-	return flags.StatusUnknown
+	return StatusResult{Status: flags.StatusUnknown, Reason: "NoStatusHandler", Message: "no status handler for this kind"}, registered
 }
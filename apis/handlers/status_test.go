@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/apis/flags"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestRegisterStatusHandlerReplaces(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Cache"}
+	status := func(s string) StatusHander {
+		return func(metav1.Object) string { return s }
+	}
+	obj := newObject("example.com/v1", "Cache", nil)
+
+	RegisterStatusHandler(gvk, status(flags.StatusProgressing))
+	RegisterStatusHandler(gvk, status(flags.StatusReady))
+	assert.Equal(t, flags.StatusReady, TryStatusHandler(obj))
+
+	// an Unknown status passes on to the result handlers
+	RegisterStatusHandler(gvk, status(flags.StatusUnknown))
+	RegisterStatusResultHandler(gvk, func(metav1.Object) (StatusResult, bool) {
+		return StatusResult{Status: flags.StatusFailed, Reason: "Evicted"}, true
+	})
+	assert.Equal(t, StatusResult{Status: flags.StatusFailed, Reason: "Evicted"}, TryStatusResultHandler(obj))
+}
@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/oam-dev/oam-go-sdk/apis/fieldpath"
+	"github.com/oam-dev/oam-go-sdk/apis/flags"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// ReadinessRule tells the status of objects of a GVK from their fields, fields are JSONPath
+// expressions as used by kubectl, e.g: "{.status.phase}".
+type ReadinessRule struct {
+	// APIVersion and Kind the rule applies to, only needed for rules loaded with LoadReadinessRules.
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	// FieldPath of the field telling readiness
+	FieldPath string `json:"fieldPath"`
+	// ReadyValues of the field meaning ready, "true" if empty
+	ReadyValues []string `json:"readyValues,omitempty"`
+	// FailedValues of the field meaning failed
+	FailedValues []string `json:"failedValues,omitempty"`
+	// ReadyReplicasPath and DesiredReplicasPath of the replica counts of a workload, optional
+	ReadyReplicasPath   string `json:"readyReplicasPath,omitempty"`
+	DesiredReplicasPath string `json:"desiredReplicasPath,omitempty"`
+}
+
+var readinessRules = make(map[string]ReadinessRule)
+
+var typedScheme *runtime.Scheme
+var typedStatus func(r metav1.Object) (result StatusResult, ok bool)
+
+// RegisterTypedStatus makes EvaluateUnstructured convert objects of the kinds of scheme to their typed
+// object and evaluate them with evaluate first, replacing the scheme registered before. The core.oam.dev
+// API registers the k8s build-in kinds it evaluates.
+func RegisterTypedStatus(scheme *runtime.Scheme, evaluate func(r metav1.Object) (result StatusResult, ok bool)) {
+	statusHandlerLock.Lock()
+	defer statusHandlerLock.Unlock()
+	typedScheme, typedStatus = scheme, evaluate
+}
+
+// ToTyped converts u to its typed object if its GVK is known to the scheme registered with
+// RegisterTypedStatus, ok is false otherwise.
+func ToTyped(u *unstructured.Unstructured) (typed metav1.Object, ok bool) {
+	statusHandlerLock.Lock()
+	scheme := typedScheme
+	statusHandlerLock.Unlock()
+	if scheme == nil {
+		return nil, false
+	}
+	obj, err := scheme.New(u.GroupVersionKind())
+	if err != nil {
+		return nil, false
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj); err != nil {
+		return nil, false
+	}
+	obj.GetObjectKind().SetGroupVersionKind(u.GroupVersionKind())
+	typed, ok = obj.(metav1.Object)
+	return typed, ok
+}
+
+// RegisterReadinessRule sets the readiness rule of gvk, objects without rule are evaluated by
+// their Ready condition.
+func RegisterReadinessRule(gvk schema.GroupVersionKind, rule ReadinessRule) {
+	statusHandlerLock.Lock()
+	defer statusHandlerLock.Unlock()
+	readinessRules[FormatGVK(gvk)] = rule
+}
+
+// LoadReadinessRules registers readiness rules from a yaml or json list, so custom workloads get
+// their status from configuration, e.g:
+//   - apiVersion: example.com/v1
+//     kind: Database
+//     fieldPath: .status.phase
+//     readyValues: [Running]
+//     failedValues: [Error]
+func LoadReadinessRules(data []byte) error {
+	var rules []ReadinessRule
+	if err := yaml.Unmarshal(data, &rules); err != nil {
+		return err
+	}
+	for i, rule := range rules {
+		if rule.Kind == "" || rule.FieldPath == "" {
+			return fmt.Errorf("readiness rule %d: kind and fieldPath required", i)
+		}
+		RegisterReadinessRule(schema.FromAPIVersionAndKind(rule.APIVersion, rule.Kind), rule)
+	}
+	return nil
+}
+
+// EvaluateUnstructured evaluates status of an object from its fields: objects of the kinds registered with
+// RegisterTypedStatus are evaluated as typed objects, otherwise an observedGeneration behind generation
+// means progressing, then the readiness rule of its GVK or its Ready condition tells.
+// ok is false if none of them applies.
+func EvaluateUnstructured(u *unstructured.Unstructured) (result StatusResult, ok bool) {
+	if typed, isTyped := ToTyped(u); isTyped {
+		statusHandlerLock.Lock()
+		evaluate := typedStatus
+		statusHandlerLock.Unlock()
+		if result, ok := evaluate(typed); ok {
+			return result, true
+		}
+	}
+
+	observed, found, err := unstructured.NestedInt64(u.Object, "status", "observedGeneration")
+	if err == nil && found && observed < u.GetGeneration() {
+		return StatusResult{
+			Status:  flags.StatusProgressing,
+			Reason:  "GenerationNotObserved",
+			Message: fmt.Sprintf("generation %d not observed yet, %d observed", u.GetGeneration(), observed),
+		}, true
+	}
+
+	statusHandlerLock.Lock()
+	rule, hasRule := readinessRules[FormatGVK(u.GroupVersionKind())]
+	statusHandlerLock.Unlock()
+	if hasRule {
+		return evaluateRule(u, rule), true
+	}
+	return conditionStatus(u)
+}
+
+func evaluateRule(u *unstructured.Unstructured, rule ReadinessRule) StatusResult {
+	result := StatusResult{Status: flags.StatusProgressing}
+	result.ReadyReplicas = replicasAt(u, rule.ReadyReplicasPath)
+	result.DesiredReplicas = replicasAt(u, rule.DesiredReplicasPath)
+
+	v, found, err := fieldpath.Get(u, rule.FieldPath)
+	switch {
+	case err != nil:
+		result.Status = flags.StatusUnknown
+		result.Reason = "InvalidReadinessRule"
+		result.Message = err.Error()
+		return result
+	case !found:
+		result.Reason = "FieldNotFound"
+		result.Message = "field " + rule.FieldPath + " not set yet"
+		return result
+	}
+	readyValues := rule.ReadyValues
+	if len(readyValues) == 0 {
+		readyValues = []string{"true"}
+	}
+	switch {
+	case contains(readyValues, v):
+		result.Status = flags.StatusReady
+	case contains(rule.FailedValues, v):
+		result.Status = flags.StatusFailed
+		result.Reason = v
+		result.Message = "field " + rule.FieldPath + " is " + v
+	default:
+		result.Reason = v
+		result.Message = "field " + rule.FieldPath + " is " + v
+	}
+	return result
+}
+
+// conditionStatus evaluates the standard status.conditions[type=Ready] convention.
+func conditionStatus(u *unstructured.Unstructured) (StatusResult, bool) {
+	conditions, found, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil || !found {
+		return StatusResult{}, false
+	}
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok || cond["type"] != "Ready" {
+			continue
+		}
+		reason, _ := cond["reason"].(string)
+		message, _ := cond["message"].(string)
+		if cond["status"] == "True" {
+			return StatusResult{Status: flags.StatusReady}, true
+		}
+		return StatusResult{Status: flags.StatusProgressing, Reason: reason, Message: message}, true
+	}
+	return StatusResult{}, false
+}
+
+func replicasAt(u *unstructured.Unstructured, path string) int32 {
+	if path == "" {
+		return 0
+	}
+	v, found, err := fieldpath.Get(u, path)
+	if err != nil || !found {
+		return 0
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(n)
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/apis/flags"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func newObject(apiVersion, kind string, status map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"name": "test", "generation": int64(2)},
+		"status":     status,
+	}}
+}
+
+func TestEvaluateUnstructuredConditions(t *testing.T) {
+	u := newObject("example.com/v1", "Cache", map[string]interface{}{
+		"observedGeneration": int64(2),
+		"conditions": []interface{}{
+			map[string]interface{}{"type": "Synced", "status": "True"},
+			map[string]interface{}{"type": "Ready", "status": "False", "reason": "Creating", "message": "creating nodes"},
+		},
+	})
+	result, ok := EvaluateUnstructured(u)
+	assert.True(t, ok)
+	assert.Equal(t, StatusResult{Status: flags.StatusProgressing, Reason: "Creating", Message: "creating nodes"}, result)
+
+	u.Object["status"].(map[string]interface{})["conditions"] = []interface{}{
+		map[string]interface{}{"type": "Ready", "status": "True"},
+	}
+	result, ok = EvaluateUnstructured(u)
+	assert.True(t, ok)
+	assert.Equal(t, flags.StatusReady, result.Status)
+
+	// status of a previous generation doesn't tell
+	u.SetGeneration(3)
+	result, ok = EvaluateUnstructured(u)
+	assert.True(t, ok)
+	assert.Equal(t, "GenerationNotObserved", result.Reason)
+
+	_, ok = EvaluateUnstructured(newObject("example.com/v1", "Cache", map[string]interface{}{}))
+	assert.False(t, ok)
+}
+
+func TestLoadReadinessRules(t *testing.T) {
+	assert.NoError(t, LoadReadinessRules([]byte(`
+- apiVersion: example.com/v1
+  kind: Database
+  fieldPath: .status.phase
+  readyValues: [Running]
+  failedValues: [Error]
+  readyReplicasPath: .status.readyInstances
+  desiredReplicasPath: .spec.instances
+`)))
+	u := newObject("example.com/v1", "Database", map[string]interface{}{"phase": "Pending", "readyInstances": int64(1)})
+	u.Object["spec"] = map[string]interface{}{"instances": int64(3)}
+	result, ok := EvaluateUnstructured(u)
+	assert.True(t, ok)
+	assert.Equal(t, StatusResult{
+		Status: flags.StatusProgressing, Reason: "Pending", Message: "field .status.phase is Pending",
+		ReadyReplicas: 1, DesiredReplicas: 3,
+	}, result)
+
+	u.Object["status"].(map[string]interface{})["phase"] = "Running"
+	result, _ = EvaluateUnstructured(u)
+	assert.Equal(t, flags.StatusReady, result.Status)
+	u.Object["status"].(map[string]interface{})["phase"] = "Error"
+	result, _ = EvaluateUnstructured(u)
+	assert.Equal(t, flags.StatusFailed, result.Status)
+
+	assert.Error(t, LoadReadinessRules([]byte(`[{"kind": "Database"}]`)))
+}
+
+func TestEvaluateUnstructuredTyped(t *testing.T) {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, corev1.AddToScheme(scheme))
+	RegisterTypedStatus(scheme, func(r metav1.Object) (StatusResult, bool) {
+		pod, ok := r.(*corev1.Pod)
+		if !ok {
+			return StatusResult{}, false
+		}
+		return StatusResult{Status: flags.StatusProgressing, Reason: string(pod.Status.Phase)}, true
+	})
+	defer RegisterTypedStatus(nil, nil)
+
+	result, ok := EvaluateUnstructured(newObject("v1", "Pod", map[string]interface{}{"phase": "Pending"}))
+	assert.True(t, ok)
+	assert.Equal(t, "Pending", result.Reason)
+
+	// typed objects the evaluation can't tell are evaluated from their fields
+	result, ok = EvaluateUnstructured(newObject("v1", "Service", map[string]interface{}{
+		"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}},
+	}))
+	assert.True(t, ok)
+	assert.Equal(t, flags.StatusReady, result.Status)
+}
@@ -0,0 +1,45 @@
+// Command oam is a toolbox for OAM manifests.
+//
+//	oam lint [-o text|json] [-strict] path...
+//
+// Rendering needs the handlers of a controller, which oam doesn't have: render.Command is a render
+// subcommand controllers add to their own binary, with their handlers.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/oam-dev/oam-go-sdk/pkg/lint"
+)
+
+const usage = `Usage: oam <command> [arguments]
+
+Commands:
+  lint    check v1alpha1 manifests offline
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	var err error
+	switch os.Args[1] {
+	case "lint":
+		err = lint.Command(os.Args[2:], os.Stdout)
+	case "help", "-h", "--help":
+		fmt.Fprint(os.Stdout, usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "oam: unknown command %q\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	switch {
+	case err == lint.ErrProblems:
+		os.Exit(1)
+	case err != nil:
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+}
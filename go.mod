@@ -4,13 +4,23 @@ go 1.13
 
 require (
 	github.com/go-logr/logr v0.1.0
+	github.com/google/go-cmp v0.5.8 // indirect
 	github.com/onsi/ginkgo v1.10.1
 	github.com/onsi/gomega v1.7.0
-	github.com/stretchr/testify v1.4.0
+	github.com/prometheus/client_golang v1.0.0
+	github.com/stretchr/testify v1.9.0
+	// otel v1.3.0 and later require go-logr/logr v1, which controller-runtime v0.4 can't use.
+	// otel requires testify v1.7.0 or later.
+	go.opentelemetry.io/otel v1.0.0-RC1
+	go.opentelemetry.io/otel/sdk v1.0.0-RC1
+	go.opentelemetry.io/otel/trace v1.0.0-RC1
 	golang.org/x/net v0.0.0-20191004110552-13f9640d40b9
+	golang.org/x/xerrors v0.0.0-20220907171357-04be3eba64a2 // indirect
+	gopkg.in/yaml.v3 v3.0.1
 	k8s.io/api v0.17.0
+	k8s.io/apiextensions-apiserver v0.17.0 // indirect
 	k8s.io/apimachinery v0.17.0
 	k8s.io/client-go v0.17.0
 	sigs.k8s.io/controller-runtime v0.4.0
-	sigs.k8s.io/controller-tools v0.2.5 // indirect
+	sigs.k8s.io/yaml v1.1.0
 )
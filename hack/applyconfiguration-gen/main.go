@@ -0,0 +1,404 @@
+// applyconfiguration-gen writes the apply configurations of the core.oam.dev/v1alpha1 kinds, the declarative
+// configurations sent by the Apply methods of the typed clients. Fields of apply configurations are pointers,
+// slices and maps serialized with omitempty, so that only the fields set by the caller are applied.
+//
+// It is run by hack/update-client-gen.sh, the kinds are read with reflection from the API types.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+)
+
+var kinds = []interface{}{
+	v1alpha1.ApplicationConfiguration{},
+	v1alpha1.ApplicationScope{},
+	v1alpha1.ComponentSchematic{},
+	v1alpha1.Trait{},
+	v1alpha1.WorkloadType{},
+}
+
+// aliases are the import names of the packages of the fields.
+var aliases = map[string]string{
+	reflect.TypeOf(v1alpha1.Trait{}).PkgPath(): "coreoamdevv1alpha1",
+	"k8s.io/api/core/v1":                       "corev1",
+	"k8s.io/apimachinery/pkg/apis/meta/v1":     "metav1",
+}
+
+func main() {
+	output := flag.String("output-dir", "pkg/client/applyconfiguration/core.oam.dev/v1alpha1", "directory the apply configurations are written to")
+	header := flag.String("go-header-file", "hack/boilerplate.go.txt", "file of the header of the generated files")
+	flag.Parse()
+
+	boilerplate, err := ioutil.ReadFile(*header)
+	if err != nil {
+		fail(err)
+	}
+	files := map[string]*generator{"meta.go": newGenerator()}
+	files["meta.go"].meta()
+	seen := map[reflect.Type]bool{}
+	var types []reflect.Type
+	for _, k := range kinds {
+		types = append(types, reflect.TypeOf(k))
+	}
+	for len(types) > 0 {
+		t := types[0]
+		types = types[1:]
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		g := newGenerator()
+		types = append(types, g.object(t)...)
+		files[strings.ToLower(t.Name())+".go"] = g
+	}
+
+	if err := os.MkdirAll(*output, 0755); err != nil {
+		fail(err)
+	}
+	for name, g := range files {
+		src, err := g.source(boilerplate)
+		if err != nil {
+			fail(fmt.Errorf("%s: %v", name, err))
+		}
+		if err := ioutil.WriteFile(filepath.Join(*output, name), src, 0644); err != nil {
+			fail(err)
+		}
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
+
+type generator struct {
+	imports map[string]bool
+	body    bytes.Buffer
+}
+
+func newGenerator() *generator {
+	return &generator{imports: map[string]bool{}}
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.body, format, args...)
+}
+
+// source returns the formatted file of g.
+func (g *generator) source(boilerplate []byte) ([]byte, error) {
+	var src bytes.Buffer
+	src.Write(boilerplate)
+	src.WriteString("// Code generated by applyconfiguration-gen. DO NOT EDIT.\n\npackage v1alpha1\n\n")
+	var paths []string
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	if len(paths) > 0 {
+		src.WriteString("import (\n")
+		for _, path := range paths {
+			fmt.Fprintf(&src, "%s %q\n", alias(path), path)
+		}
+		src.WriteString(")\n\n")
+	}
+	src.Write(g.body.Bytes())
+	return format.Source(src.Bytes())
+}
+
+func alias(path string) string {
+	if a, ok := aliases[path]; ok {
+		return a
+	}
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// isKind tells if t is the type of a kind, its apply configuration has the type and object meta.
+func isKind(t reflect.Type) bool {
+	_, ok := t.FieldByName("ObjectMeta")
+	return ok
+}
+
+// configured returns the struct type of t if it has an apply configuration: API types other than kinds, and
+// pointers to them.
+func configured(t reflect.Type) (reflect.Type, bool) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t, t.Kind() == reflect.Struct && t.PkgPath() == aliasPath("coreoamdevv1alpha1")
+}
+
+func aliasPath(a string) string {
+	for path, alias := range aliases {
+		if alias == a {
+			return path
+		}
+	}
+	return ""
+}
+
+// typeName returns the name of t in generated files, importing its package.
+func (g *generator) typeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + g.typeName(t.Elem())
+	case reflect.Slice:
+		return "[]" + g.typeName(t.Elem())
+	case reflect.Map:
+		return "map[" + g.typeName(t.Key()) + "]" + g.typeName(t.Elem())
+	}
+	if t.PkgPath() == "" {
+		return t.Name()
+	}
+	g.imports[t.PkgPath()] = true
+	return alias(t.PkgPath()) + "." + t.Name()
+}
+
+// field is a field of an apply configuration.
+type field struct {
+	name string
+	json string
+	// typ is the type of the field in the apply configuration.
+	typ string
+	// elem is the type of the values of the builder of a slice or of the value of the builder of a scalar.
+	elem string
+	kind reflect.Kind
+	// configured tells if elem is an apply configuration.
+	configured bool
+}
+
+// object writes the apply configuration of the struct t and returns the API types of its fields.
+func (g *generator) object(t reflect.Type) []reflect.Type {
+	var fields []field
+	var nested []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous || f.PkgPath != "" {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		c := field{name: f.Name, json: name, kind: f.Type.Kind()}
+		switch f.Type.Kind() {
+		case reflect.Slice, reflect.Map:
+			if elem, ok := configured(f.Type.Elem()); ok {
+				nested = append(nested, elem)
+				c.elem, c.configured = elem.Name()+"ApplyConfiguration", true
+			} else {
+				c.elem = g.typeName(f.Type.Elem())
+			}
+			if f.Type.Kind() == reflect.Map {
+				c.typ = "map[" + g.typeName(f.Type.Key()) + "]" + c.elem
+			} else {
+				c.typ = "[]" + c.elem
+			}
+		default:
+			if elem, ok := configured(f.Type); ok {
+				nested = append(nested, elem)
+				c.elem, c.configured = "*"+elem.Name()+"ApplyConfiguration", true
+				c.typ = c.elem
+			} else {
+				c.elem = g.typeName(f.Type)
+				c.typ = "*" + c.elem
+			}
+		}
+		fields = append(fields, c)
+	}
+
+	name := t.Name() + "ApplyConfiguration"
+	g.printf("// %s represents a declarative configuration of the %s type for use\n// with apply.\n", name, t.Name())
+	g.printf("type %s struct {\n", name)
+	if isKind(t) {
+		g.printf("TypeMetaApplyConfiguration `json:\",inline\"`\n*ObjectMetaApplyConfiguration `json:\"metadata,omitempty\"`\n")
+	}
+	for _, f := range fields {
+		g.printf("%s %s `json:\"%s,omitempty\"`\n", f.name, f.typ, f.json)
+	}
+	g.printf("}\n\n")
+
+	if isKind(t) {
+		g.kind(t)
+	} else {
+		g.printf("// %s constructs a declarative configuration of the %s type for use with\n// apply.\n", t.Name(), t.Name())
+		g.printf("func %s() *%s {\nreturn &%s{}\n}\n\n", t.Name(), name, name)
+	}
+	for _, f := range fields {
+		g.builder(name, f)
+	}
+	return nested
+}
+
+// kind writes the constructor and the builders of the type and object meta of the apply configuration of kind t.
+func (g *generator) kind(t reflect.Type) {
+	name := t.Name() + "ApplyConfiguration"
+	g.printf(`// %[1]s constructs a declarative configuration of the %[1]s type for use with
+// apply.
+func %[1]s(name, namespace string) *%[2]s {
+	b := &%[2]s{}
+	b.WithName(name)
+	b.WithNamespace(namespace)
+	b.WithKind(%[1]q)
+	b.WithAPIVersion(%[3]q)
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+func (b *%[2]s) WithKind(value string) *%[2]s {
+	b.Kind = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+func (b *%[2]s) WithAPIVersion(value string) *%[2]s {
+	b.APIVersion = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+func (b *%[2]s) WithName(value string) *%[2]s {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+func (b *%[2]s) WithNamespace(value string) *%[2]s {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.Namespace = &value
+	return b
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *%[2]s) WithLabels(entries map[string]string) *%[2]s {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.Labels == nil && len(entries) > 0 {
+		b.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Labels[k] = v
+	}
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *%[2]s) WithAnnotations(entries map[string]string) *%[2]s {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.Annotations == nil && len(entries) > 0 {
+		b.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Annotations[k] = v
+	}
+	return b
+}
+
+func (b *%[2]s) ensureObjectMetaApplyConfigurationExists() {
+	if b.ObjectMetaApplyConfiguration == nil {
+		b.ObjectMetaApplyConfiguration = &ObjectMetaApplyConfiguration{}
+	}
+}
+
+// GetName retrieves the value of the Name field in the declarative configuration.
+func (b *%[2]s) GetName() *string {
+	b.ensureObjectMetaApplyConfigurationExists()
+	return b.Name
+}
+
+`, t.Name(), name, v1alpha1.SchemeGroupVersion.String())
+}
+
+// builder writes the With method of field f of the apply configuration name.
+func (g *generator) builder(name string, f field) {
+	switch {
+	case f.kind == reflect.Slice:
+		value, elem := "values[i]", f.elem
+		if f.configured {
+			value, elem = "*values[i]", "*"+f.elem
+		}
+		g.printf(`// With%[2]s adds the given value to the %[2]s field in the declarative configuration
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the %[2]s field.
+func (b *%[1]s) With%[2]s(values ...%[3]s) *%[1]s {
+	for i := range values {
+`, name, f.name, elem)
+		if f.configured {
+			g.printf("if values[i] == nil {\npanic(\"nil value passed to With%s\")\n}\n", f.name)
+		}
+		g.printf("b.%s = append(b.%s, %s)\n}\nreturn b\n}\n\n", f.name, f.name, value)
+	case f.kind == reflect.Map:
+		g.printf(`// With%[2]s puts the entries into the %[2]s field in the declarative configuration
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the %[2]s field,
+// overwriting an existing map entries in %[2]s field with the same key.
+func (b *%[1]s) With%[2]s(entries %[3]s) *%[1]s {
+	if b.%[2]s == nil && len(entries) > 0 {
+		b.%[2]s = make(%[3]s, len(entries))
+	}
+	for k, v := range entries {
+		b.%[2]s[k] = v
+	}
+	return b
+}
+
+`, name, f.name, f.typ)
+	default:
+		value := "&value"
+		if f.configured {
+			value = "value"
+		}
+		g.printf(`// With%[2]s sets the %[2]s field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the %[2]s field is set to the value of the last call.
+func (b *%[1]s) With%[2]s(value %[3]s) *%[1]s {
+	b.%[2]s = %[4]s
+	return b
+}
+
+`, name, f.name, f.elem, value)
+	}
+}
+
+// meta writes the apply configurations of the type and object meta of kinds.
+func (g *generator) meta() {
+	g.printf(`// TypeMetaApplyConfiguration represents a declarative configuration of the TypeMeta type for use
+// with apply.
+type TypeMetaApplyConfiguration struct {
+	Kind       *string ` + "`json:\"kind,omitempty\"`" + `
+	APIVersion *string ` + "`json:\"apiVersion,omitempty\"`" + `
+}
+
+// ObjectMetaApplyConfiguration represents a declarative configuration of the ObjectMeta type for use
+// with apply, the fields of the object meta kinds can apply.
+type ObjectMetaApplyConfiguration struct {
+	Name        *string           ` + "`json:\"name,omitempty\"`" + `
+	Namespace   *string           ` + "`json:\"namespace,omitempty\"`" + `
+	Labels      map[string]string ` + "`json:\"labels,omitempty\"`" + `
+	Annotations map[string]string ` + "`json:\"annotations,omitempty\"`" + `
+}
+`)
+}
@@ -6,7 +6,9 @@ set -o pipefail
 
 SCRIPT_ROOT=$(dirname ${BASH_SOURCE})/..
 echo ${SCRIPT_ROOT}
+# The code-generator version matches the k8s.io/client-go version of go.mod, v0.17.
 CODEGEN_PKG=${CODEGEN_PKG:-$(cd ${SCRIPT_ROOT}; ls -d -1 ./code-gen-vendor/k8s.io/code-generator 2>/dev/null || echo ../../../k8s.io/code-generator)}
+# WithContext and Apply methods are hand written in *_expansion.go files, client-gen keeps them.
 
 # generate the code with:
 # --output-base    because this script should also be able to run inside the vendor dir of
@@ -16,4 +18,10 @@ ${CODEGEN_PKG}/generate-groups.sh "client,informer,lister" \
   github.com/oam-dev/oam-go-sdk/pkg/client github.com/oam-dev/oam-go-sdk/apis \
   core.oam.dev:v1alpha1 \
   --output-base "$(dirname ${BASH_SOURCE})/../../../.." \
-  --go-header-file ${SCRIPT_ROOT}/hack/boilerplate.go.txt
\ No newline at end of file
+  --go-header-file ${SCRIPT_ROOT}/hack/boilerplate.go.txt
+
+# applyconfiguration-gen is not part of code-generator v0.17.
+rm -rf ${SCRIPT_ROOT}/pkg/client/applyconfiguration
+(cd ${SCRIPT_ROOT} && go run ./hack/applyconfiguration-gen \
+  --output-dir pkg/client/applyconfiguration/core.oam.dev/v1alpha1 \
+  --go-header-file hack/boilerplate.go.txt)
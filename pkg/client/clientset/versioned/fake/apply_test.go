@@ -0,0 +1,41 @@
+package fake
+
+import (
+	"context"
+	"testing"
+
+	v1alpha1 "github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	coreoamdevv1alpha1 "github.com/oam-dev/oam-go-sdk/pkg/client/applyconfiguration/core.oam.dev/v1alpha1"
+	corev1alpha1 "github.com/oam-dev/oam-go-sdk/pkg/client/clientset/versioned/typed/core.oam.dev/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApply(t *testing.T) {
+	ctx := context.Background()
+	traits := NewSimpleClientset().CoreV1alpha1().Traits("default")
+	trait := coreoamdevv1alpha1.Trait("rollout", "default").
+		WithSpec(coreoamdevv1alpha1.TraitSpec().WithGroup("apps").WithVersion("v1"))
+	_, err := traits.Apply(ctx, trait, corev1alpha1.ApplyOptions{FieldManager: "test"})
+	assert.NoError(t, err)
+
+	trait = coreoamdevv1alpha1.Trait("rollout", "default").WithSpec(coreoamdevv1alpha1.TraitSpec().WithGroup("core"))
+	_, err = traits.Apply(ctx, trait, corev1alpha1.ApplyOptions{FieldManager: "test"})
+	assert.NoError(t, err)
+	got, err := traits.Get("rollout", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "core", got.Spec.Group)
+	assert.Equal(t, "v1", got.Spec.Version, "fields not applied are kept")
+
+	apps := NewSimpleClientset(&v1alpha1.ApplicationConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec:       v1alpha1.ApplicationConfigurationSpec{Components: []v1alpha1.ComponentConfiguration{{ComponentName: "web"}}},
+	}).CoreV1alpha1().ApplicationConfigurations("default")
+	ac := coreoamdevv1alpha1.ApplicationConfiguration("app", "default").
+		WithSpec(coreoamdevv1alpha1.ApplicationConfigurationSpec()).
+		WithStatus(coreoamdevv1alpha1.ApplicationConfigurationStatus().WithPhase(v1alpha1.ApplicationReady))
+	applied, err := apps.WithContext(ctx).ApplyStatus(ctx, ac, corev1alpha1.ApplyOptions{FieldManager: "test"})
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.ApplicationReady, applied.Status.Phase)
+	assert.Len(t, applied.Spec.Components, 1)
+}
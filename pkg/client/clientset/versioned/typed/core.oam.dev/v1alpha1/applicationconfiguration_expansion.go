@@ -0,0 +1,60 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	v1alpha1 "github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	coreoamdevv1alpha1 "github.com/oam-dev/oam-go-sdk/pkg/client/applyconfiguration/core.oam.dev/v1alpha1"
+)
+
+// ApplicationConfigurationExpansion has the methods of ApplicationConfigurationInterface not generated by client-gen.
+type ApplicationConfigurationExpansion interface {
+	// WithContext returns a client of applicationconfigurations whose requests carry ctx.
+	WithContext(ctx context.Context) ApplicationConfigurationInterface
+	// Apply applies applicationConfiguration server side and returns the server's representation of the applicationConfiguration.
+	Apply(ctx context.Context, applicationConfiguration *coreoamdevv1alpha1.ApplicationConfigurationApplyConfiguration, opts ApplyOptions) (*v1alpha1.ApplicationConfiguration, error)
+	// ApplyStatus applies the status of applicationConfiguration server side and returns the server's representation of the applicationConfiguration.
+	ApplyStatus(ctx context.Context, applicationConfiguration *coreoamdevv1alpha1.ApplicationConfigurationApplyConfiguration, opts ApplyOptions) (*v1alpha1.ApplicationConfiguration, error)
+}
+
+// WithContext returns a client of applicationconfigurations whose requests carry ctx.
+func (c *applicationConfigurations) WithContext(ctx context.Context) ApplicationConfigurationInterface {
+	return &applicationConfigurations{client: withContext(ctx, c.client), ns: c.ns}
+}
+
+// Apply applies applicationConfiguration server side and returns the server's representation of the applicationConfiguration.
+func (c *applicationConfigurations) Apply(ctx context.Context, applicationConfiguration *coreoamdevv1alpha1.ApplicationConfigurationApplyConfiguration, opts ApplyOptions) (result *v1alpha1.ApplicationConfiguration, err error) {
+	if applicationConfiguration == nil {
+		return nil, fmt.Errorf("applicationConfiguration provided to Apply must not be nil")
+	}
+	result = &v1alpha1.ApplicationConfiguration{}
+	err = apply(ctx, c.client, c.ns, "applicationconfigurations", applicationConfiguration.GetName(), applicationConfiguration, opts, result)
+	return
+}
+
+// ApplyStatus applies the status of applicationConfiguration server side and returns the server's representation of the applicationConfiguration. The
+// server ignores the other fields of the apply configuration.
+func (c *applicationConfigurations) ApplyStatus(ctx context.Context, applicationConfiguration *coreoamdevv1alpha1.ApplicationConfigurationApplyConfiguration, opts ApplyOptions) (result *v1alpha1.ApplicationConfiguration, err error) {
+	if applicationConfiguration == nil {
+		return nil, fmt.Errorf("applicationConfiguration provided to ApplyStatus must not be nil")
+	}
+	result = &v1alpha1.ApplicationConfiguration{}
+	err = apply(ctx, c.client, c.ns, "applicationconfigurations", applicationConfiguration.GetName(), applicationConfiguration, opts, result, "status")
+	return
+}
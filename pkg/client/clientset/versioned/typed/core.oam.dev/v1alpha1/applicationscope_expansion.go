@@ -0,0 +1,47 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	v1alpha1 "github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	coreoamdevv1alpha1 "github.com/oam-dev/oam-go-sdk/pkg/client/applyconfiguration/core.oam.dev/v1alpha1"
+)
+
+// ApplicationScopeExpansion has the methods of ApplicationScopeInterface not generated by client-gen.
+type ApplicationScopeExpansion interface {
+	// WithContext returns a client of applicationscopes whose requests carry ctx.
+	WithContext(ctx context.Context) ApplicationScopeInterface
+	// Apply applies applicationScope server side and returns the server's representation of the applicationScope.
+	Apply(ctx context.Context, applicationScope *coreoamdevv1alpha1.ApplicationScopeApplyConfiguration, opts ApplyOptions) (*v1alpha1.ApplicationScope, error)
+}
+
+// WithContext returns a client of applicationscopes whose requests carry ctx.
+func (c *applicationScopes) WithContext(ctx context.Context) ApplicationScopeInterface {
+	return &applicationScopes{client: withContext(ctx, c.client), ns: c.ns}
+}
+
+// Apply applies applicationScope server side and returns the server's representation of the applicationScope.
+func (c *applicationScopes) Apply(ctx context.Context, applicationScope *coreoamdevv1alpha1.ApplicationScopeApplyConfiguration, opts ApplyOptions) (result *v1alpha1.ApplicationScope, err error) {
+	if applicationScope == nil {
+		return nil, fmt.Errorf("applicationScope provided to Apply must not be nil")
+	}
+	result = &v1alpha1.ApplicationScope{}
+	err = apply(ctx, c.client, c.ns, "applicationscopes", applicationScope.GetName(), applicationScope, opts, result)
+	return
+}
@@ -0,0 +1,105 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	scheme "github.com/oam-dev/oam-go-sdk/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	types "k8s.io/apimachinery/pkg/types"
+	rest "k8s.io/client-go/rest"
+)
+
+// ApplyOptions configures a server-side apply. The apply configurations applied, in
+// pkg/client/applyconfiguration, only have the fields set by the caller: these fields are owned by FieldManager.
+type ApplyOptions struct {
+	// FieldManager is the name of the actor applying, required.
+	FieldManager string
+	// Force takes fields owned by other managers over instead of failing with a conflict.
+	Force bool
+	// DryRun, if set to "All", doesn't persist the result.
+	DryRun []string
+}
+
+// PatchOptions returns the options of the apply patch.
+func (o ApplyOptions) PatchOptions() v1.PatchOptions {
+	force := o.Force
+	return v1.PatchOptions{FieldManager: o.FieldManager, Force: &force, DryRun: o.DryRun}
+}
+
+// apply sends the apply configuration obj of the resource named name, or of its subresource, and decodes the
+// server's representation of the resource in result.
+func apply(ctx context.Context, client rest.Interface, namespace, resource string, name *string, obj interface{}, opts ApplyOptions, result runtime.Object, subresources ...string) error {
+	if name == nil {
+		return fmt.Errorf("the name of the %s applied must be provided", resource)
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	patchOptions := opts.PatchOptions()
+	return client.Patch(types.ApplyPatchType).
+		Namespace(namespace).
+		Resource(resource).
+		Name(*name).
+		SubResource(subresources...).
+		VersionedParams(&patchOptions, scheme.ParameterCodec).
+		Body(data).
+		Context(ctx).
+		Do().
+		Into(result)
+}
+
+// contextClient is a rest.Interface whose requests carry a context, typed clients returned by WithContext use it.
+type contextClient struct {
+	rest.Interface
+	ctx context.Context
+}
+
+func withContext(ctx context.Context, client rest.Interface) rest.Interface {
+	if c, ok := client.(contextClient); ok {
+		client = c.Interface
+	}
+	return contextClient{Interface: client, ctx: ctx}
+}
+
+func (c contextClient) Verb(verb string) *rest.Request {
+	return c.Interface.Verb(verb).Context(c.ctx)
+}
+
+func (c contextClient) Post() *rest.Request {
+	return c.Interface.Post().Context(c.ctx)
+}
+
+func (c contextClient) Put() *rest.Request {
+	return c.Interface.Put().Context(c.ctx)
+}
+
+func (c contextClient) Patch(pt types.PatchType) *rest.Request {
+	return c.Interface.Patch(pt).Context(c.ctx)
+}
+
+func (c contextClient) Get() *rest.Request {
+	return c.Interface.Get().Context(c.ctx)
+}
+
+func (c contextClient) Delete() *rest.Request {
+	return c.Interface.Delete().Context(c.ctx)
+}
@@ -0,0 +1,106 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	v1alpha1 "github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	coreoamdevv1alpha1 "github.com/oam-dev/oam-go-sdk/pkg/client/applyconfiguration/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/pkg/client/clientset/versioned/scheme"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest/fake"
+)
+
+func TestApply(t *testing.T) {
+	var body map[string]interface{}
+	rc := &fake.RESTClient{
+		NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		GroupVersion:         v1alpha1.SchemeGroupVersion,
+		VersionedAPIPath:     "/apis/core.oam.dev/v1alpha1",
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			data, _ := ioutil.ReadAll(req.Body)
+			body = nil
+			if len(data) > 0 {
+				assert.NoError(t, json.Unmarshal(data, &body))
+			} else {
+				data = []byte(`{"metadata":{"name":"app"}}`)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       ioutil.NopCloser(bytes.NewReader(data)),
+			}, nil
+		}),
+	}
+	ac := coreoamdevv1alpha1.ApplicationConfiguration("app", "default").
+		WithStatus(coreoamdevv1alpha1.ApplicationConfigurationStatus().WithPhase(v1alpha1.ApplicationReady))
+
+	applied, err := New(rc).ApplicationConfigurations("default").ApplyStatus(context.Background(), ac,
+		ApplyOptions{FieldManager: "oam", Force: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "app", applied.Name)
+
+	req := rc.Req
+	assert.Equal(t, http.MethodPatch, req.Method)
+	assert.Equal(t, string(types.ApplyPatchType), req.Header.Get("Content-Type"))
+	assert.Equal(t, "/apis/core.oam.dev/v1alpha1/namespaces/default/applicationconfigurations/app/status", req.URL.Path)
+	assert.Equal(t, "oam", req.URL.Query().Get("fieldManager"))
+	assert.Equal(t, "true", req.URL.Query().Get("force"))
+	assert.Equal(t, map[string]interface{}{
+		"apiVersion": "core.oam.dev/v1alpha1",
+		"kind":       "ApplicationConfiguration",
+		"metadata":   map[string]interface{}{"name": "app", "namespace": "default"},
+		"status":     map[string]interface{}{"phase": "Ready"},
+	}, body)
+
+	ac = coreoamdevv1alpha1.ApplicationConfiguration("app", "default").
+		WithSpec(coreoamdevv1alpha1.ApplicationConfigurationSpec().
+			WithComponents(coreoamdevv1alpha1.ComponentConfiguration().WithComponentName("web")))
+	_, err = New(rc).ApplicationConfigurations("default").Apply(context.Background(), ac, ApplyOptions{FieldManager: "oam"})
+	assert.NoError(t, err)
+	assert.Equal(t, "/apis/core.oam.dev/v1alpha1/namespaces/default/applicationconfigurations/app", rc.Req.URL.Path)
+	assert.Equal(t, map[string]interface{}{
+		"components": []interface{}{map[string]interface{}{"componentName": "web"}},
+	}, body["spec"], "fields not set aren't applied")
+
+	scope := coreoamdevv1alpha1.ApplicationScope("net", "default").
+		WithSpec(coreoamdevv1alpha1.ApplicationScopeSpec().WithAllowComponentOverlap(false))
+	_, err = New(rc).ApplicationScopes("default").Apply(context.Background(), scope, ApplyOptions{FieldManager: "oam"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"allowComponentOverlap": false}, body["spec"], "zero values set are applied")
+
+	_, err = New(rc).Traits("default").Apply(context.Background(), &coreoamdevv1alpha1.TraitApplyConfiguration{},
+		ApplyOptions{FieldManager: "oam"})
+	assert.Error(t, err, "the name is required")
+}
+
+func TestWithContext(t *testing.T) {
+	rc := &fake.RESTClient{
+		NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		GroupVersion:         v1alpha1.SchemeGroupVersion,
+		VersionedAPIPath:     "/apis/core.oam.dev/v1alpha1",
+		Client: fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"metadata":{"name":"web"}}`))),
+			}, nil
+		}),
+	}
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "reconcile")
+	schematics := New(rc).ComponentSchematics("default").WithContext(ctx)
+	_, err := schematics.Get("web", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "reconcile", rc.Req.Context().Value(key{}))
+
+	_, err = schematics.WithContext(context.Background()).Get("web", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Nil(t, rc.Req.Context().Value(key{}), "the last context replaces the previous one")
+}
@@ -0,0 +1,60 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	v1alpha1 "github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	coreoamdevv1alpha1 "github.com/oam-dev/oam-go-sdk/pkg/client/applyconfiguration/core.oam.dev/v1alpha1"
+)
+
+// ComponentSchematicExpansion has the methods of ComponentSchematicInterface not generated by client-gen.
+type ComponentSchematicExpansion interface {
+	// WithContext returns a client of componentschematics whose requests carry ctx.
+	WithContext(ctx context.Context) ComponentSchematicInterface
+	// Apply applies componentSchematic server side and returns the server's representation of the componentSchematic.
+	Apply(ctx context.Context, componentSchematic *coreoamdevv1alpha1.ComponentSchematicApplyConfiguration, opts ApplyOptions) (*v1alpha1.ComponentSchematic, error)
+	// ApplyStatus applies the status of componentSchematic server side and returns the server's representation of the componentSchematic.
+	ApplyStatus(ctx context.Context, componentSchematic *coreoamdevv1alpha1.ComponentSchematicApplyConfiguration, opts ApplyOptions) (*v1alpha1.ComponentSchematic, error)
+}
+
+// WithContext returns a client of componentschematics whose requests carry ctx.
+func (c *componentSchematics) WithContext(ctx context.Context) ComponentSchematicInterface {
+	return &componentSchematics{client: withContext(ctx, c.client), ns: c.ns}
+}
+
+// Apply applies componentSchematic server side and returns the server's representation of the componentSchematic.
+func (c *componentSchematics) Apply(ctx context.Context, componentSchematic *coreoamdevv1alpha1.ComponentSchematicApplyConfiguration, opts ApplyOptions) (result *v1alpha1.ComponentSchematic, err error) {
+	if componentSchematic == nil {
+		return nil, fmt.Errorf("componentSchematic provided to Apply must not be nil")
+	}
+	result = &v1alpha1.ComponentSchematic{}
+	err = apply(ctx, c.client, c.ns, "componentschematics", componentSchematic.GetName(), componentSchematic, opts, result)
+	return
+}
+
+// ApplyStatus applies the status of componentSchematic server side and returns the server's representation of the componentSchematic. The
+// server ignores the other fields of the apply configuration.
+func (c *componentSchematics) ApplyStatus(ctx context.Context, componentSchematic *coreoamdevv1alpha1.ComponentSchematicApplyConfiguration, opts ApplyOptions) (result *v1alpha1.ComponentSchematic, err error) {
+	if componentSchematic == nil {
+		return nil, fmt.Errorf("componentSchematic provided to ApplyStatus must not be nil")
+	}
+	result = &v1alpha1.ComponentSchematic{}
+	err = apply(ctx, c.client, c.ns, "componentschematics", componentSchematic.GetName(), componentSchematic, opts, result, "status")
+	return
+}
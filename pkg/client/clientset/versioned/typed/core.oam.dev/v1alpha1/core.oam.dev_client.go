@@ -28,6 +28,7 @@ type CoreV1alpha1Interface interface {
 	ApplicationScopesGetter
 	ComponentSchematicsGetter
 	TraitsGetter
+	WorkloadTypesGetter
 }
 
 // CoreV1alpha1Client is used to interact with features provided by the core.oam.dev group.
@@ -51,6 +52,10 @@ func (c *CoreV1alpha1Client) Traits(namespace string) TraitInterface {
 	return newTraits(c, namespace)
 }
 
+func (c *CoreV1alpha1Client) WorkloadTypes(namespace string) WorkloadTypeInterface {
+	return newWorkloadTypes(c, namespace)
+}
+
 // NewForConfig creates a new CoreV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*CoreV1alpha1Client, error) {
 	config := *c
@@ -0,0 +1,77 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1alpha1 "github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	coreoamdevv1alpha1 "github.com/oam-dev/oam-go-sdk/pkg/client/applyconfiguration/core.oam.dev/v1alpha1"
+	corev1alpha1 "github.com/oam-dev/oam-go-sdk/pkg/client/clientset/versioned/typed/core.oam.dev/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// WithContext returns c, the fake client doesn't use contexts.
+func (c *FakeApplicationConfigurations) WithContext(ctx context.Context) corev1alpha1.ApplicationConfigurationInterface {
+	return c
+}
+
+// Apply creates applicationConfiguration, or merges it into the existing one as a merge patch: the object tracker doesn't support
+// apply patches, lists of the apply configuration replace the existing ones.
+func (c *FakeApplicationConfigurations) Apply(ctx context.Context, applicationConfiguration *coreoamdevv1alpha1.ApplicationConfigurationApplyConfiguration, opts corev1alpha1.ApplyOptions) (*v1alpha1.ApplicationConfiguration, error) {
+	if applicationConfiguration == nil {
+		return nil, fmt.Errorf("applicationConfiguration provided to Apply must not be nil")
+	}
+	name := applicationConfiguration.GetName()
+	if name == nil {
+		return nil, fmt.Errorf("the name of the applicationconfigurations applied must be provided")
+	}
+	data, err := json.Marshal(applicationConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.Get(*name, v1.GetOptions{}); errors.IsNotFound(err) {
+		obj := &v1alpha1.ApplicationConfiguration{}
+		if err := json.Unmarshal(data, obj); err != nil {
+			return nil, err
+		}
+		obj.Namespace = c.ns
+		return c.Create(obj)
+	} else if err != nil {
+		return nil, err
+	}
+	return c.Patch(*name, types.MergePatchType, data)
+}
+
+// ApplyStatus merges the status of applicationConfiguration into the existing one as a merge patch.
+func (c *FakeApplicationConfigurations) ApplyStatus(ctx context.Context, applicationConfiguration *coreoamdevv1alpha1.ApplicationConfigurationApplyConfiguration, opts corev1alpha1.ApplyOptions) (*v1alpha1.ApplicationConfiguration, error) {
+	if applicationConfiguration == nil {
+		return nil, fmt.Errorf("applicationConfiguration provided to ApplyStatus must not be nil")
+	}
+	name := applicationConfiguration.GetName()
+	if name == nil {
+		return nil, fmt.Errorf("the name of the applicationconfigurations applied must be provided")
+	}
+	data, err := json.Marshal(&coreoamdevv1alpha1.ApplicationConfigurationApplyConfiguration{Status: applicationConfiguration.Status})
+	if err != nil {
+		return nil, err
+	}
+	return c.Patch(*name, types.MergePatchType, data, "status")
+}
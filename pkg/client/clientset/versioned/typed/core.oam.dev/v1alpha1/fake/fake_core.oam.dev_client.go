@@ -42,6 +42,10 @@ func (c *FakeCoreV1alpha1) Traits(namespace string) v1alpha1.TraitInterface {
 	return &FakeTraits{c, namespace}
 }
 
+func (c *FakeCoreV1alpha1) WorkloadTypes(namespace string) v1alpha1.WorkloadTypeInterface {
+	return &FakeWorkloadTypes{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeCoreV1alpha1) RESTClient() rest.Interface {
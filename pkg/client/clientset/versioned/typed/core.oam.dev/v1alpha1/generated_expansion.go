@@ -15,11 +15,3 @@ limitations under the License.
 // Code generated by client-gen. DO NOT EDIT.
 
 package v1alpha1
-
-type ApplicationConfigurationExpansion interface{}
-
-type ApplicationScopeExpansion interface{}
-
-type ComponentSchematicExpansion interface{}
-
-type TraitExpansion interface{}
@@ -0,0 +1,47 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	v1alpha1 "github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	coreoamdevv1alpha1 "github.com/oam-dev/oam-go-sdk/pkg/client/applyconfiguration/core.oam.dev/v1alpha1"
+)
+
+// TraitExpansion has the methods of TraitInterface not generated by client-gen.
+type TraitExpansion interface {
+	// WithContext returns a client of traits whose requests carry ctx.
+	WithContext(ctx context.Context) TraitInterface
+	// Apply applies trait server side and returns the server's representation of the trait.
+	Apply(ctx context.Context, trait *coreoamdevv1alpha1.TraitApplyConfiguration, opts ApplyOptions) (*v1alpha1.Trait, error)
+}
+
+// WithContext returns a client of traits whose requests carry ctx.
+func (c *traits) WithContext(ctx context.Context) TraitInterface {
+	return &traits{client: withContext(ctx, c.client), ns: c.ns}
+}
+
+// Apply applies trait server side and returns the server's representation of the trait.
+func (c *traits) Apply(ctx context.Context, trait *coreoamdevv1alpha1.TraitApplyConfiguration, opts ApplyOptions) (result *v1alpha1.Trait, err error) {
+	if trait == nil {
+		return nil, fmt.Errorf("trait provided to Apply must not be nil")
+	}
+	result = &v1alpha1.Trait{}
+	err = apply(ctx, c.client, c.ns, "traits", trait.GetName(), trait, opts, result)
+	return
+}
@@ -0,0 +1,47 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	v1alpha1 "github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	coreoamdevv1alpha1 "github.com/oam-dev/oam-go-sdk/pkg/client/applyconfiguration/core.oam.dev/v1alpha1"
+)
+
+// WorkloadTypeExpansion has the methods of WorkloadTypeInterface not generated by client-gen.
+type WorkloadTypeExpansion interface {
+	// WithContext returns a client of workloadtypes whose requests carry ctx.
+	WithContext(ctx context.Context) WorkloadTypeInterface
+	// Apply applies workloadType server side and returns the server's representation of the workloadType.
+	Apply(ctx context.Context, workloadType *coreoamdevv1alpha1.WorkloadTypeApplyConfiguration, opts ApplyOptions) (*v1alpha1.WorkloadType, error)
+}
+
+// WithContext returns a client of workloadtypes whose requests carry ctx.
+func (c *workloadTypes) WithContext(ctx context.Context) WorkloadTypeInterface {
+	return &workloadTypes{client: withContext(ctx, c.client), ns: c.ns}
+}
+
+// Apply applies workloadType server side and returns the server's representation of the workloadType.
+func (c *workloadTypes) Apply(ctx context.Context, workloadType *coreoamdevv1alpha1.WorkloadTypeApplyConfiguration, opts ApplyOptions) (result *v1alpha1.WorkloadType, err error) {
+	if workloadType == nil {
+		return nil, fmt.Errorf("workloadType provided to Apply must not be nil")
+	}
+	result = &v1alpha1.WorkloadType{}
+	err = apply(ctx, c.client, c.ns, "workloadtypes", workloadType.GetName(), workloadType, opts, result)
+	return
+}
@@ -30,6 +30,8 @@ type Interface interface {
 	ComponentSchematics() ComponentSchematicInformer
 	// Traits returns a TraitInformer.
 	Traits() TraitInformer
+	// WorkloadTypes returns a WorkloadTypeInformer.
+	WorkloadTypes() WorkloadTypeInformer
 }
 
 type version struct {
@@ -62,3 +64,8 @@ func (v *version) ComponentSchematics() ComponentSchematicInformer {
 func (v *version) Traits() TraitInformer {
 	return &traitInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// WorkloadTypes returns a WorkloadTypeInformer.
+func (v *version) WorkloadTypes() WorkloadTypeInformer {
+	return &workloadTypeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -59,6 +59,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().ComponentSchematics().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("traits"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().Traits().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("workloadtypes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Core().V1alpha1().WorkloadTypes().Informer()}, nil
 
 	}
 
@@ -0,0 +1,115 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Names of the indexes of ApplicationConfigurations by the names of ComponentSchematics, Traits and
+// ApplicationScopes they reference, see ApplicationConfigurationIndexers.
+const (
+	ComponentIndex = "component"
+	TraitIndex     = "trait"
+	ScopeIndex     = "scope"
+)
+
+// ApplicationConfigurationIndexers returns indexes of ApplicationConfigurations by the objects they
+// reference, add them to an informer before starting it to make reverse lookups index based.
+func ApplicationConfigurationIndexers() cache.Indexers {
+	return cache.Indexers{
+		ComponentIndex: namespacedIndexFunc(func(ac *v1alpha1.ApplicationConfiguration) []string { return ac.Spec.ComponentNames() }),
+		TraitIndex:     namespacedIndexFunc(func(ac *v1alpha1.ApplicationConfiguration) []string { return ac.Spec.TraitNames() }),
+		ScopeIndex:     namespacedIndexFunc(func(ac *v1alpha1.ApplicationConfiguration) []string { return ac.Spec.ScopeNames() }),
+	}
+}
+
+func namespacedIndexFunc(names func(ac *v1alpha1.ApplicationConfiguration) []string) cache.IndexFunc {
+	return func(obj interface{}) ([]string, error) {
+		ac, ok := obj.(*v1alpha1.ApplicationConfiguration)
+		if !ok {
+			return nil, nil
+		}
+		var keys []string
+		for _, n := range names(ac) {
+			keys = append(keys, ac.Namespace+"/"+n)
+		}
+		return keys, nil
+	}
+}
+
+// ApplicationConfigurationNamespaceListerExpansion allows custom methods to be added to
+// ApplicationConfigurationNamespaceLister.
+type ApplicationConfigurationNamespaceListerExpansion interface {
+	// ByComponent lists the ApplicationConfigurations referencing the ComponentSchematic name.
+	ByComponent(name string) ([]*v1alpha1.ApplicationConfiguration, error)
+	// ByTrait lists the ApplicationConfigurations binding the Trait name.
+	ByTrait(name string) ([]*v1alpha1.ApplicationConfiguration, error)
+	// ByScope lists the ApplicationConfigurations with components in the ApplicationScope name.
+	ByScope(name string) ([]*v1alpha1.ApplicationConfiguration, error)
+}
+
+// ByComponent lists the ApplicationConfigurations referencing the ComponentSchematic name.
+func (s applicationConfigurationNamespaceLister) ByComponent(name string) ([]*v1alpha1.ApplicationConfiguration, error) {
+	return s.byIndex(ComponentIndex, name)
+}
+
+// ByTrait lists the ApplicationConfigurations binding the Trait name.
+func (s applicationConfigurationNamespaceLister) ByTrait(name string) ([]*v1alpha1.ApplicationConfiguration, error) {
+	return s.byIndex(TraitIndex, name)
+}
+
+// ByScope lists the ApplicationConfigurations with components in the ApplicationScope name.
+func (s applicationConfigurationNamespaceLister) ByScope(name string) ([]*v1alpha1.ApplicationConfiguration, error) {
+	return s.byIndex(ScopeIndex, name)
+}
+
+// byIndex lists ApplicationConfigurations by index, the namespace is scanned if the indexer lacks the index.
+func (s applicationConfigurationNamespaceLister) byIndex(index, name string) ([]*v1alpha1.ApplicationConfiguration, error) {
+	if _, ok := s.indexer.GetIndexers()[index]; !ok {
+		return s.scan(index, name)
+	}
+	objs, err := s.indexer.ByIndex(index, s.namespace+"/"+name)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*v1alpha1.ApplicationConfiguration, 0, len(objs))
+	for _, m := range objs {
+		ret = append(ret, m.(*v1alpha1.ApplicationConfiguration))
+	}
+	return ret, nil
+}
+
+func (s applicationConfigurationNamespaceLister) scan(index, name string) ([]*v1alpha1.ApplicationConfiguration, error) {
+	keys := ApplicationConfigurationIndexers()[index]
+	all, err := s.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var ret []*v1alpha1.ApplicationConfiguration
+	for _, ac := range all {
+		values, _ := keys(ac)
+		for _, v := range values {
+			if v == s.namespace+"/"+name {
+				ret = append(ret, ac)
+				break
+			}
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,65 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	v1alpha1 "github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func names(acs []*v1alpha1.ApplicationConfiguration) []string {
+	var ret []string
+	for _, ac := range acs {
+		ret = append(ret, ac.Name)
+	}
+	return ret
+}
+
+func TestByReference(t *testing.T) {
+	app := func(ns, name, comp, trait string) *v1alpha1.ApplicationConfiguration {
+		return &v1alpha1.ApplicationConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name},
+			Spec: v1alpha1.ApplicationConfigurationSpec{Components: []v1alpha1.ComponentConfiguration{
+				{ComponentName: comp, Traits: []v1alpha1.TraitBinding{{Name: trait}}, ApplicationScopes: []string{"net"}},
+			}},
+		}
+	}
+	indexed := cache.NewIndexer(cache.MetaNamespaceKeyFunc, ApplicationConfigurationIndexers())
+	plain := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, ac := range []*v1alpha1.ApplicationConfiguration{
+		app("default", "a", "web", "rollout"), app("default", "b", "db", "rollout"), app("other", "c", "web", "rollout"),
+	} {
+		assert.NoError(t, indexed.Add(ac))
+		assert.NoError(t, plain.Add(ac))
+	}
+
+	// lookups are the same with or without indexes
+	for _, indexer := range []cache.Indexer{indexed, plain} {
+		lister := NewApplicationConfigurationLister(indexer).ApplicationConfigurations("default")
+		acs, err := lister.ByComponent("web")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a"}, names(acs))
+		acs, err = lister.ByTrait("rollout")
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"a", "b"}, names(acs))
+		acs, err = lister.ByScope("missing")
+		assert.NoError(t, err)
+		assert.Empty(t, acs)
+	}
+}
+
+func TestByWorkloadType(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, ComponentSchematicIndexers())
+	for name, wt := range map[string]string{"web": "core.oam.dev/v1alpha1.Server", "job": "core.oam.dev/v1alpha1.Task"} {
+		assert.NoError(t, indexer.Add(&v1alpha1.ComponentSchematic{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec:       v1alpha1.ComponentSpec{WorkloadType: wt},
+		}))
+	}
+	comps, err := NewComponentSchematicLister(indexer).ComponentSchematics("default").ByWorkloadType("core.oam.dev/v1alpha1.Server")
+	assert.NoError(t, err)
+	assert.Len(t, comps, 1)
+	assert.Equal(t, "web", comps[0].Name)
+}
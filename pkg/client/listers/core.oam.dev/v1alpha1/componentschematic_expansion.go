@@ -0,0 +1,74 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WorkloadTypeIndex is the name of the index of ComponentSchematics by workload type, see
+// ComponentSchematicIndexers.
+const WorkloadTypeIndex = "workloadType"
+
+// ComponentSchematicIndexers returns the index of ComponentSchematics by workload type, add it to an informer
+// before starting it to make lookups by workload type index based.
+func ComponentSchematicIndexers() cache.Indexers {
+	return cache.Indexers{WorkloadTypeIndex: workloadTypeIndexFunc}
+}
+
+func workloadTypeIndexFunc(obj interface{}) ([]string, error) {
+	comp, ok := obj.(*v1alpha1.ComponentSchematic)
+	if !ok || comp.Spec.WorkloadType == "" {
+		return nil, nil
+	}
+	return []string{comp.Namespace + "/" + comp.Spec.WorkloadType}, nil
+}
+
+// ComponentSchematicNamespaceListerExpansion allows custom methods to be added to
+// ComponentSchematicNamespaceLister.
+type ComponentSchematicNamespaceListerExpansion interface {
+	// ByWorkloadType lists the ComponentSchematics of workloadType.
+	ByWorkloadType(workloadType string) ([]*v1alpha1.ComponentSchematic, error)
+}
+
+// ByWorkloadType lists the ComponentSchematics of workloadType, the namespace is scanned if the indexer lacks
+// the index.
+func (s componentSchematicNamespaceLister) ByWorkloadType(workloadType string) ([]*v1alpha1.ComponentSchematic, error) {
+	if _, ok := s.indexer.GetIndexers()[WorkloadTypeIndex]; !ok {
+		all, err := s.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		var ret []*v1alpha1.ComponentSchematic
+		for _, comp := range all {
+			if comp.Spec.WorkloadType == workloadType {
+				ret = append(ret, comp)
+			}
+		}
+		return ret, nil
+	}
+	objs, err := s.indexer.ByIndex(WorkloadTypeIndex, s.namespace+"/"+workloadType)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*v1alpha1.ComponentSchematic, 0, len(objs))
+	for _, m := range objs {
+		ret = append(ret, m.(*v1alpha1.ComponentSchematic))
+	}
+	return ret, nil
+}
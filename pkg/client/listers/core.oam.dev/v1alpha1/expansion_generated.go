@@ -20,10 +20,6 @@ package v1alpha1
 // ApplicationConfigurationLister.
 type ApplicationConfigurationListerExpansion interface{}
 
-// ApplicationConfigurationNamespaceListerExpansion allows custom methods to be added to
-// ApplicationConfigurationNamespaceLister.
-type ApplicationConfigurationNamespaceListerExpansion interface{}
-
 // ApplicationScopeListerExpansion allows custom methods to be added to
 // ApplicationScopeLister.
 type ApplicationScopeListerExpansion interface{}
@@ -36,10 +32,6 @@ type ApplicationScopeNamespaceListerExpansion interface{}
 // ComponentSchematicLister.
 type ComponentSchematicListerExpansion interface{}
 
-// ComponentSchematicNamespaceListerExpansion allows custom methods to be added to
-// ComponentSchematicNamespaceLister.
-type ComponentSchematicNamespaceListerExpansion interface{}
-
 // TraitListerExpansion allows custom methods to be added to
 // TraitLister.
 type TraitListerExpansion interface{}
@@ -47,3 +39,11 @@ type TraitListerExpansion interface{}
 // TraitNamespaceListerExpansion allows custom methods to be added to
 // TraitNamespaceLister.
 type TraitNamespaceListerExpansion interface{}
+
+// WorkloadTypeListerExpansion allows custom methods to be added to
+// WorkloadTypeLister.
+type WorkloadTypeListerExpansion interface{}
+
+// WorkloadTypeNamespaceListerExpansion allows custom methods to be added to
+// WorkloadTypeNamespaceLister.
+type WorkloadTypeNamespaceListerExpansion interface{}
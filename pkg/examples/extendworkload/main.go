@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
@@ -171,8 +172,8 @@ type Handler struct {
 	newCrd    bool
 }
 
-func (s *Handler) HandleComponent(namespace string, comp v1alpha1.ComponentConfiguration) error {
-	compIns, err := s.oamclient.CoreV1alpha1().ComponentSchematics(namespace).Get(comp.ComponentName, v1.GetOptions{})
+func (s *Handler) HandleComponent(ctx context.Context, namespace string, comp v1alpha1.ComponentConfiguration) error {
+	compIns, err := s.oamclient.CoreV1alpha1().ComponentSchematics(namespace).WithContext(ctx).Get(comp.ComponentName, v1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("get component %s err %v", comp.ComponentName, err)
 	}
@@ -190,17 +191,17 @@ func (s *Handler) Handle(ctx *oam.ActionContext, comp runtime.Object, eType oam.
 	switch appConfig := comp.(type) {
 	case *v1alpha1.ApplicationConfiguration:
 		for _, comp := range appConfig.Spec.Components {
-			if err := s.HandleComponent(appConfig.Namespace, comp); err != nil {
+			if err := s.HandleComponent(ctx.Context(), appConfig.Namespace, comp); err != nil {
 				return err
 			}
 		}
 		appConfig.Status.Phase = "updated"
-		if _, err := s.oamclient.CoreV1alpha1().ApplicationConfigurations(appConfig.Namespace).UpdateStatus(appConfig); err != nil {
+		if _, err := s.oamclient.CoreV1alpha1().ApplicationConfigurations(appConfig.Namespace).WithContext(ctx.Context()).UpdateStatus(appConfig); err != nil {
 			return err
 		}
 	case *ApplicationConfiguration:
 		for _, comp := range appConfig.Spec.Components {
-			if err := s.HandleComponent(appConfig.Namespace, comp); err != nil {
+			if err := s.HandleComponent(ctx.Context(), appConfig.Namespace, comp); err != nil {
 				return err
 			}
 		}
@@ -100,7 +100,7 @@ func (s *Handler) Handle(ctx *oam.ActionContext, obj runtime.Object, eType oam.E
 	}
 	setupLog.Info("oam handler: " + s.name + " received ApplicationConfiguration " + ac.Name)
 	for _, compConf := range ac.Spec.Components {
-		comp, err := s.oamclient.CoreV1alpha1().ComponentSchematics(ac.Namespace).Get(compConf.ComponentName, v1.GetOptions{})
+		comp, err := s.oamclient.CoreV1alpha1().ComponentSchematics(ac.Namespace).WithContext(ctx.Context()).Get(compConf.ComponentName, v1.GetOptions{})
 		if err != nil {
 			return err
 		}
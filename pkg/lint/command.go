@@ -0,0 +1,57 @@
+package lint
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+)
+
+// ErrProblems is returned by Command when errors are found.
+var ErrProblems = errors.New("lint: errors found")
+
+// Command runs the lint subcommand with args, the arguments following the subcommand name:
+//
+//	lint [-o text|json] [-strict] path...
+//
+// Problems of the manifests under paths are written to out, one per line or as a JSON array.
+// ErrProblems is returned if an error is found, or a warning with -strict.
+func Command(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("lint", flag.ContinueOnError)
+	output := fs.String("o", "text", "Output format, text or json.")
+	strict := fs.Bool("strict", false, "Fail on warnings too.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *output != "text" && *output != "json" {
+		return fmt.Errorf("lint: unknown output format %q", *output)
+	}
+	if fs.NArg() == 0 {
+		return errors.New("lint: no path given")
+	}
+	problems, err := Paths(fs.Args()...)
+	if err != nil {
+		return err
+	}
+	if *output == "json" {
+		if problems == nil {
+			problems = []Problem{}
+		}
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(problems); err != nil {
+			return err
+		}
+	} else {
+		for _, p := range problems {
+			if _, err := fmt.Fprintln(out, p); err != nil {
+				return err
+			}
+		}
+	}
+	if HasErrors(problems) || (*strict && len(problems) > 0) {
+		return ErrProblems
+	}
+	return nil
+}
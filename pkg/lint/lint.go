@@ -0,0 +1,336 @@
+// Package lint checks v1alpha1 OAM manifests offline for mistakes otherwise only caught by runtimes:
+// references to missing components, traits not applicable to a workload type, duplicate instance names,
+// undefined parameters.
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/oam-dev/oam-go-sdk/apis/common"
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Severity of a problem.
+type Severity string
+
+const (
+	// SeverityError is a mistake making an application fail.
+	SeverityError Severity = "error"
+	// SeverityWarning is a likely mistake, such as a reference to an object not found in the manifests
+	// but which may exist in the cluster.
+	SeverityWarning Severity = "warning"
+)
+
+// Rules problems are reported for.
+const (
+	RuleInvalid               = "invalid"
+	RuleComponentMissing      = "component-missing"
+	RuleInstanceNameDuplicate = "instance-name-duplicate"
+	RuleParameterUndefined    = "parameter-undefined"
+	RuleParameterRequired     = "parameter-required"
+	RuleFromParamUndefined    = "from-param-undefined"
+	RuleTraitNotApplicable    = "trait-not-applicable"
+	RuleTraitMissing          = "trait-missing"
+	RuleWorkloadTypeMissing   = "workload-type-missing"
+	RuleScopeMissing          = "scope-missing"
+	RuleDependencyInvalid     = "dependency-invalid"
+)
+
+// Position of a problem, Line is 1-based, 0 if unknown.
+type Position struct {
+	File string `json:"file"`
+	Line int    `json:"line,omitempty"`
+}
+
+// Problem found in a manifest.
+type Problem struct {
+	Position
+	Severity Severity `json:"severity"`
+	Rule     string   `json:"rule"`
+	// Object the problem is in, kind/namespace/name
+	Object  string `json:"object,omitempty"`
+	Message string `json:"message"`
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s: %s: %s [%s]", p.Position, p.Severity, p.Message, p.Rule)
+}
+
+// HasErrors returns whether problems has a problem of SeverityError.
+func HasErrors(problems []Problem) bool {
+	for _, p := range problems {
+		if p.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Paths loads the manifests of paths and lints them, see Load.
+func Paths(paths ...string) ([]Problem, error) {
+	objs, problems, err := Load(paths...)
+	if err != nil {
+		return nil, err
+	}
+	problems = append(problems, Lint(objs)...)
+	Sort(problems)
+	return problems, nil
+}
+
+// Sort sorts problems by position.
+func Sort(problems []Problem) {
+	sort.SliceStable(problems, func(i, j int) bool {
+		if problems[i].File != problems[j].File {
+			return problems[i].File < problems[j].File
+		}
+		return problems[i].Line < problems[j].Line
+	})
+}
+
+// linter holds the objects linted together, by namespace and name.
+type linter struct {
+	components map[string]*v1alpha1.ComponentSchematic
+	traits     map[string]*v1alpha1.Trait
+	scopes     map[string]bool
+	types      map[string]bool
+	problems   []Problem
+}
+
+func key(namespace, name string) string {
+	if namespace == "" {
+		namespace = "default"
+	}
+	return namespace + "/" + name
+}
+
+// Lint checks objs against each other. References are looked up in the namespace of the referencing
+// object, objects without namespace are in the default namespace.
+func Lint(objs []*Object) []Problem {
+	l := &linter{
+		components: map[string]*v1alpha1.ComponentSchematic{},
+		traits:     map[string]*v1alpha1.Trait{},
+		scopes:     map[string]bool{},
+		types:      map[string]bool{},
+	}
+	for _, o := range objs {
+		switch obj := o.Object.(type) {
+		case *v1alpha1.ComponentSchematic:
+			l.components[key(obj.Namespace, obj.Name)] = obj
+		case *v1alpha1.Trait:
+			l.traits[key(obj.Namespace, obj.Name)] = obj
+		case *v1alpha1.ApplicationScope:
+			l.scopes[key(obj.Namespace, obj.Name)] = true
+		case *v1alpha1.WorkloadType:
+			l.types[obj.Spec.Group+"/"+obj.Spec.Version+"."+obj.Spec.Names.Kind] = true
+		}
+	}
+	instances := map[string]string{}
+	for _, o := range objs {
+		switch obj := o.Object.(type) {
+		case *v1alpha1.ComponentSchematic:
+			l.lintComponent(o, obj)
+		case *v1alpha1.ApplicationConfiguration:
+			l.lintApplication(o, obj, instances)
+		}
+	}
+	return l.problems
+}
+
+func (l *linter) report(o *Object, severity Severity, rule string, path []interface{}, format string, args ...interface{}) {
+	l.problems = append(l.problems, Problem{
+		Position: o.Position(path...),
+		Severity: severity,
+		Rule:     rule,
+		Object:   objectName(o.Object),
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// objectName returns kind/namespace/name of obj.
+func objectName(obj runtime.Object) string {
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return obj.GetObjectKind().GroupVersionKind().Kind + "/" + key(m.GetNamespace(), m.GetName())
+}
+
+func path(p ...interface{}) []interface{} {
+	return p
+}
+
+// isCore returns whether t is a core workload type, defined by the specification.
+func isCore(t string) bool {
+	return strings.HasPrefix(t, v1alpha1.SchemeGroupVersion.String()+".")
+}
+
+func (l *linter) lintComponent(o *Object, comp *v1alpha1.ComponentSchematic) {
+	if t := comp.Spec.WorkloadType; t != "" && !isCore(t) && !l.types[t] {
+		l.report(o, SeverityWarning, RuleWorkloadTypeMissing, path("spec", "workloadType"),
+			"workload type %s is not defined by a WorkloadType", t)
+	}
+	params := map[string]bool{}
+	for _, p := range comp.Spec.Parameters {
+		params[p.Name] = true
+	}
+	for i, c := range comp.Spec.Containers {
+		for j, e := range c.Env {
+			if e.FromParam != "" && !params[e.FromParam] {
+				l.report(o, SeverityError, RuleFromParamUndefined, path("spec", "containers", i, "env", j, "fromParam"),
+					"env %s of container %s reads undefined parameter %s", e.Name, c.Name, e.FromParam)
+			}
+		}
+		for j, f := range c.Config {
+			if f.FromParam != "" && !params[f.FromParam] {
+				l.report(o, SeverityError, RuleFromParamUndefined, path("spec", "containers", i, "config", j, "fromParam"),
+					"config file %s of container %s reads undefined parameter %s", f.Path, c.Name, f.FromParam)
+			}
+		}
+	}
+	if _, err := common.ParamReferences(comp.Spec.WorkloadSettings); err != nil {
+		l.report(o, SeverityError, RuleInvalid, path("spec", "workloadSettings"), "invalid workload settings: %v", err)
+	}
+}
+
+func (l *linter) lintApplication(o *Object, ac *v1alpha1.ApplicationConfiguration, instances map[string]string) {
+	names := map[string]bool{}
+	for _, c := range ac.Spec.Components {
+		names[c.ComponentName] = true
+	}
+	for i, c := range ac.Spec.Components {
+		at := func(p ...interface{}) []interface{} {
+			return append([]interface{}{"spec", "components", i}, p...)
+		}
+		if c.InstanceName != "" {
+			k := key(ac.Namespace, c.InstanceName)
+			if other, ok := instances[k]; ok {
+				l.report(o, SeverityError, RuleInstanceNameDuplicate, at("instanceName"),
+					"instance name %s is already used by %s", c.InstanceName, other)
+			} else {
+				instances[k] = fmt.Sprintf("component %s of %s", c.ComponentName, ac.Name)
+			}
+		}
+		for j, dep := range c.DependsOn {
+			if !names[dep] {
+				l.report(o, SeverityError, RuleDependencyInvalid, at("dependsOn", j),
+					"component %s depends on %s, which is not a component of the application", c.ComponentName, dep)
+			}
+		}
+		for j, v := range c.ParameterValues {
+			if v.From != nil && v.From.Component != "" && !names[v.From.Component] {
+				l.report(o, SeverityError, RuleDependencyInvalid, at("parameterValues", j, "from", "component"),
+					"parameter %s reads %s, which is not a component of the application", v.Name, v.From.Component)
+			}
+		}
+		for j, s := range c.ApplicationScopes {
+			if !l.scopes[key(ac.Namespace, s)] {
+				l.report(o, SeverityWarning, RuleScopeMissing, at("applicationScopes", j), "application scope %s not found", s)
+			}
+		}
+
+		comp, ok := l.components[key(ac.Namespace, c.ComponentName)]
+		if !ok {
+			l.report(o, SeverityError, RuleComponentMissing, at("componentName"), "component %s not found", c.ComponentName)
+			l.lintTraits(o, ac, i, nil, nil)
+			continue
+		}
+		// parameters are declared, or read from parameter values by workload settings and traits
+		params := map[string]bool{}
+		for _, p := range comp.Spec.Parameters {
+			params[p.Name] = true
+		}
+		refs, _ := common.ParamReferences(comp.Spec.WorkloadSettings)
+		for _, t := range c.Traits {
+			keys, _ := common.ParamReferences(t.Properties)
+			refs = append(refs, keys...)
+		}
+		for _, k := range refs {
+			params[k] = true
+		}
+		values := map[string]bool{}
+		for j, v := range c.ParameterValues {
+			values[v.Name] = true
+			if !params[v.Name] {
+				l.report(o, SeverityError, RuleParameterUndefined, at("parameterValues", j, "name"),
+					"parameter %s is not defined by component %s", v.Name, c.ComponentName)
+			}
+		}
+		for _, p := range comp.Spec.Parameters {
+			if p.Required && p.Default == "" && !values[p.Name] {
+				l.report(o, SeverityError, RuleParameterRequired, at("componentName"),
+					"required parameter %s of component %s has no value", p.Name, c.ComponentName)
+			}
+		}
+		keys, _ := common.ParamReferences(comp.Spec.WorkloadSettings)
+		for _, k := range keys {
+			if !values[k] && !defaults(comp, k) {
+				l.report(o, SeverityError, RuleFromParamUndefined, at("componentName"),
+					"workload settings of component %s read parameter %s with [fromParam(%s)], it has no value",
+					c.ComponentName, k, k)
+			}
+		}
+		l.lintTraits(o, ac, i, comp, values)
+	}
+	// unknown dependencies are reported with the components
+	if _, err := oam.SortComponents(ac.Spec.Components); oam.IsDependencyCycle(err) {
+		l.report(o, SeverityError, RuleDependencyInvalid, path("spec", "components"), "%v", err)
+	}
+}
+
+// lintTraits checks the traits of the i-th component of ac, comp is nil if the component is missing.
+func (l *linter) lintTraits(o *Object, ac *v1alpha1.ApplicationConfiguration, i int,
+	comp *v1alpha1.ComponentSchematic, values map[string]bool) {
+	c := ac.Spec.Components[i]
+	for j, t := range c.Traits {
+		at := path("spec", "components", i, "traits", j)
+		trait, ok := l.traits[key(ac.Namespace, t.Name)]
+		if !ok {
+			l.report(o, SeverityWarning, RuleTraitMissing, append(at, "name"), "trait %s not found", t.Name)
+		} else if comp != nil && !appliesTo(trait, comp.Spec.WorkloadType) {
+			l.report(o, SeverityError, RuleTraitNotApplicable, append(at, "name"),
+				"trait %s does not apply to workload type %s of component %s, it applies to %s",
+				t.Name, comp.Spec.WorkloadType, c.ComponentName, strings.Join(trait.Spec.AppliesTo, ", "))
+		}
+		keys, err := common.ParamReferences(t.Properties)
+		if err != nil {
+			l.report(o, SeverityError, RuleInvalid, append(at, "properties"), "invalid properties of trait %s: %v", t.Name, err)
+		}
+		if comp == nil {
+			continue
+		}
+		for _, k := range keys {
+			if !values[k] && !defaults(comp, k) {
+				l.report(o, SeverityError, RuleFromParamUndefined, append(at, "properties"),
+					"trait %s reads parameter %s with [fromParam(%s)], it has no value", t.Name, k, k)
+			}
+		}
+	}
+}
+
+// appliesTo returns whether trait applies to workload type t, traits without AppliesTo apply to all types.
+func appliesTo(trait *v1alpha1.Trait, t string) bool {
+	if len(trait.Spec.AppliesTo) == 0 {
+		return true
+	}
+	for _, a := range trait.Spec.AppliesTo {
+		if a == "*" || a == t {
+			return true
+		}
+	}
+	return false
+}
+
+// defaults returns whether comp declares param with a default value.
+func defaults(comp *v1alpha1.ComponentSchematic, param string) bool {
+	for _, p := range comp.Spec.Parameters {
+		if p.Name == param && p.Default != "" {
+			return true
+		}
+	}
+	return false
+}
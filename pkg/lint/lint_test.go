@@ -0,0 +1,86 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+func TestLint(t *testing.T) {
+	problems, err := Paths("testdata/bad")
+	assert.NoError(t, err)
+	assert.True(t, HasErrors(problems))
+
+	app := filepath.Join("testdata", "bad", "app.yaml")
+	components := filepath.Join("testdata", "bad", "components.yaml")
+	type found struct {
+		Position
+		Rule string
+	}
+	var got []found
+	for _, p := range problems {
+		got = append(got, found{p.Position, p.Rule})
+	}
+	assert.Equal(t, []found{
+		{Position{app, 7}, RuleParameterRequired},
+		{Position{app, 10}, RuleParameterUndefined},
+		{Position{app, 14}, RuleFromParamUndefined},
+		{Position{app, 16}, RuleFromParamUndefined},
+		{Position{app, 17}, RuleInstanceNameDuplicate},
+		{Position{app, 19}, RuleDependencyInvalid},
+		{Position{app, 21}, RuleTraitNotApplicable},
+		{Position{app, 22}, RuleTraitMissing},
+		{Position{app, 23}, RuleComponentMissing},
+		{Position{app, 26}, RuleScopeMissing},
+		{Position{app, 28}, RuleInvalid},
+		{Position{components, 16}, RuleFromParamUndefined},
+		{Position{components, 23}, RuleWorkloadTypeMissing},
+	}, got)
+	assert.Equal(t, "ApplicationConfiguration/default/shop", problems[0].Object)
+	assert.Equal(t, app+":17: error: instance name shop is already used by component web of shop [instance-name-duplicate]",
+		problems[4].String())
+
+	problems, err = Paths("testdata/good")
+	assert.NoError(t, err)
+	assert.Empty(t, problems)
+}
+
+func TestLocate(t *testing.T) {
+	node := &yamlv3.Node{}
+	assert.NoError(t, yamlv3.Unmarshal([]byte(`spec:
+  description: |
+    components:
+  components:
+  - componentName: web
+    traits:
+    - name: a
+    - {name: b, properties: {"replicas": 2}}
+  -
+    componentName: db`), node))
+	assert.Equal(t, 3, locate(node, path("spec", "components")))
+	assert.Equal(t, 7, locate(node, path("spec", "components", 0, "traits", 1, "name")))
+	assert.Equal(t, 7, locate(node, path("spec", "components", 0, "traits", 1, "properties", "replicas")))
+	assert.Equal(t, 9, locate(node, path("spec", "components", 1, "componentName")))
+	// missing fields fall back to their closest parent
+	assert.Equal(t, 9, locate(node, path("spec", "components", 1, "traits")))
+	assert.Equal(t, 0, locate(node, path("status")))
+}
+
+func TestCommand(t *testing.T) {
+	out := new(bytes.Buffer)
+	assert.Equal(t, ErrProblems, Command([]string{"-o", "json", "testdata/bad"}, out))
+	var problems []Problem
+	assert.NoError(t, json.Unmarshal(out.Bytes(), &problems))
+	assert.Len(t, problems, 13)
+	assert.Equal(t, SeverityError, problems[0].Severity)
+
+	out.Reset()
+	assert.NoError(t, Command([]string{"testdata/good"}, out))
+	assert.Empty(t, out.String())
+	assert.Error(t, Command([]string{"-o", "xml", "testdata/good"}, out))
+	assert.Error(t, Command(nil, out))
+}
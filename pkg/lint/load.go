@@ -0,0 +1,136 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/pkg/render"
+	yamlv3 "gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/yaml"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	_ = v1alpha1.AddToScheme(scheme)
+}
+
+// Object is a v1alpha1 object loaded from a YAML document.
+type Object struct {
+	runtime.Object
+	// File the object was loaded from
+	File string
+	// Line the document of the object starts at, 1-based
+	Line int
+
+	node *yamlv3.Node
+}
+
+// Position returns the position of the field at path, keys and list indexes such as "spec", "components",
+// 0, in the document of o. The position of the closest parent found is returned if the field is not.
+func (o *Object) Position(path ...interface{}) Position {
+	return Position{File: o.File, Line: o.Line + locate(o.node, path)}
+}
+
+// Load reads the v1alpha1 objects of YAML files, as render.ReadDocuments reads them. Documents of other
+// API groups are ignored, documents which can't be decoded are reported as problems.
+func Load(paths ...string) ([]*Object, []Problem, error) {
+	docs, err := render.ReadDocuments(paths...)
+	if err != nil {
+		return nil, nil, err
+	}
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	var objs []*Object
+	var problems []Problem
+	for _, doc := range docs {
+		node := &yamlv3.Node{}
+		if err := yamlv3.Unmarshal(doc.Data, node); err != nil {
+			problems = append(problems, Problem{Position: Position{File: doc.File, Line: doc.Line + yamlErrorLine(err)},
+				Severity: SeverityError, Rule: RuleInvalid, Message: err.Error()})
+			continue
+		}
+		tm := &typeMeta{}
+		if err := yaml.Unmarshal(doc.Data, tm); err != nil {
+			problems = append(problems, Problem{Position: Position{File: doc.File, Line: doc.Line},
+				Severity: SeverityError, Rule: RuleInvalid, Message: err.Error()})
+			continue
+		}
+		if !strings.HasPrefix(tm.APIVersion, v1alpha1.Group+"/") {
+			continue
+		}
+		obj, _, err := decoder.Decode(doc.Data, nil, nil)
+		if err != nil {
+			problems = append(problems, Problem{Position: Position{File: doc.File, Line: doc.Line},
+				Severity: SeverityError, Rule: RuleInvalid, Object: tm.Kind, Message: err.Error()})
+			continue
+		}
+		objs = append(objs, &Object{Object: obj, File: doc.File, Line: doc.Line, node: node})
+	}
+	return objs, problems, nil
+}
+
+// typeMeta is the part of a document read to decide whether it is linted.
+type typeMeta struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+}
+
+var yamlLine = regexp.MustCompile(`line (\d+)`)
+
+// yamlErrorLine returns the 0-based line of a YAML syntax error in its document.
+func yamlErrorLine(err error) int {
+	if m := yamlLine.FindStringSubmatch(err.Error()); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		return n - 1
+	}
+	return 0
+}
+
+// locate returns the 0-based line of the field at path in the document node, or of its closest parent found.
+// Keys are located at their key, list items at their value.
+func locate(node *yamlv3.Node, path []interface{}) int {
+	if node == nil || len(node.Content) == 0 {
+		return 0
+	}
+	node = node.Content[0]
+	line := 0
+	for _, seg := range path {
+		var next, at *yamlv3.Node
+		switch {
+		case node.Kind == yamlv3.MappingNode:
+			key, ok := seg.(string)
+			if !ok {
+				return line
+			}
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == key {
+					next, at = node.Content[i+1], node.Content[i]
+					break
+				}
+			}
+		case node.Kind == yamlv3.SequenceNode:
+			index, ok := seg.(int)
+			if !ok || index < 0 || index >= len(node.Content) {
+				return line
+			}
+			next, at = node.Content[index], node.Content[index]
+		}
+		if next == nil {
+			return line
+		}
+		node, line = next, at.Line-1
+	}
+	return line
+}
+
+func (p Position) String() string {
+	if p.Line == 0 {
+		return p.File
+	}
+	return fmt.Sprintf("%s:%d", p.File, p.Line)
+}
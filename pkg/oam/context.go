@@ -1,10 +1,39 @@
 package oam
 
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
 type ActionContext struct {
 	PreActions  []Action
 	Actions     []Action
 	PostActions []Action
 	Values      map[string]interface{}
+
+	requeueAfter time.Duration
+	done         []Action
+	object       runtime.Object
+	recorder     record.EventRecorder
+	ctx          context.Context
+}
+
+// NewActionContext returns an ActionContext recording events on object with recorder.
+func NewActionContext(object runtime.Object, recorder record.EventRecorder) *ActionContext {
+	return &ActionContext{object: object, recorder: recorder}
+}
+
+// Context returns the context of the reconcile, carrying its trace span. Handlers use it for their
+// calls and spans, see StartSpan.
+func (o *ActionContext) Context() context.Context {
+	if o.ctx == nil {
+		return context.Background()
+	}
+	return o.ctx
 }
 
 // add actions executed before actions added through Add method
@@ -35,6 +64,32 @@ func (o *ActionContext) GetValue(k string) interface{} {
 	return o.Values[k]
 }
 
+// RequeueAfter asks to reconcile the object again after d, the shortest delay asked wins.
+func (o *ActionContext) RequeueAfter(d time.Duration) {
+	if d > 0 && (o.requeueAfter == 0 || d < o.requeueAfter) {
+		o.requeueAfter = d
+	}
+}
+
+// GetRequeueAfter returns the delay asked through RequeueAfter, 0 if none.
+func (o *ActionContext) GetRequeueAfter() time.Duration {
+	return o.requeueAfter
+}
+
+// Event records an event on the object reconciled, eventtype is corev1.EventTypeNormal or corev1.EventTypeWarning.
+// Nothing is recorded if the context has no recorder.
+func (o *ActionContext) Event(eventtype, reason, message string) {
+	if o.recorder == nil || o.object == nil {
+		return
+	}
+	o.recorder.Event(o.object, eventtype, reason, message)
+}
+
+// Eventf is like Event with a formatted message.
+func (o *ActionContext) Eventf(eventtype, reason, messageFmt string, args ...interface{}) {
+	o.Event(eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
 // clear and gather all actions according to action order.
 func (o *ActionContext) Gather() []Action {
 	var actions []Action
@@ -46,3 +101,8 @@ func (o *ActionContext) Gather() []Action {
 	o.PostActions = nil
 	return actions
 }
+
+// Done returns the actions done by the reconcile so far, in order.
+func (o *ActionContext) Done() []Action {
+	return o.done
+}
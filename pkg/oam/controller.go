@@ -17,39 +17,90 @@ package oam
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
 	"github.com/oam-dev/oam-go-sdk/pkg/config"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 )
 
+// blockedRequeueAfter is the delay before retrying components blocked by their dependencies.
+const blockedRequeueAfter = 10 * time.Second
+
 // Reconciler reconciles a runtime object in oam
 type Reconciler struct {
 	client.Client
-	specType          SType
-	Log               logr.Logger
-	Scheme            *runtime.Scheme
-	ControllerContext ControllerContext
+	specType SType
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	// Runtime the reconciler is part of, the default one if nil
+	Runtime *Runtime
+	// ActionContextFunc returns the ActionContext of a reconcile of obj, NewActionContext if nil.
+	ActionContextFunc func(obj runtime.Object, recorder record.EventRecorder) *ActionContext
+
+	// assumeReady handles components whose dependencies are not ready, see PlanOptions.
+	assumeReady bool
+}
+
+// NewReconciler returns a Reconciler of spec type tp in rt, reading and writing objects with c.
+func NewReconciler(rt *Runtime, tp SType, c client.Client, scheme *runtime.Scheme) *Reconciler {
+	return &Reconciler{
+		specType: tp,
+		Client:   c,
+		Log:      ctrl.Log.WithName("oma-controller").WithName(string(tp)),
+		Scheme:   scheme,
+		Runtime:  rt,
+	}
+}
+
+func (r *Reconciler) runtime() *Runtime {
+	if r.Runtime == nil {
+		return defaultRuntime
+	}
+	return r.Runtime
 }
 
 // +kubebuilder:rbac:groups=*,resources=*,verbs=*
-func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+func (r *Reconciler) Reconcile(req ctrl.Request) (result ctrl.Result, err error) {
 	var name = r.specType
-	ctx := context.Background()
+	r.runtime().inflight.add()
+	defer r.runtime().inflight.done()
+	ctx, span := r.runtime().getTracer().Start(context.Background(), "Reconcile "+string(name), trace.WithAttributes(
+		AttrSpecType.String(string(name)), AttrObject.String(req.NamespacedName.String())))
+	defer func() { endSpan(span, err) }()
 	log := r.Log.WithValues(string(name), req.NamespacedName)
-	actionCtx := &ActionContext{}
-
 	var conf = name.RuntimeObj()
+	newActionContext := r.ActionContextFunc
+	if newActionContext == nil {
+		newActionContext = NewActionContext
+	}
+	actionCtx := newActionContext(conf, r.Recorder)
+	actionCtx.ctx = ctx
 
 	opCode, err := r.getOpCode(ctx, req.NamespacedName, conf)
 	if err != nil {
 		if client.IgnoreNotFound(err) == nil {
 			// ignore not found error
+			if name == STypeApplicationConfiguration {
+				r.runtime().phases.set(req.NamespacedName, "", true)
+			}
 			return ctrl.Result{}, nil
 		}
 		log.Error(err, "get operate code error")
@@ -62,25 +113,13 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		eType = Delete
 	}
 
-	// invoke handler
-	handlers := getHandlers(name)
-	// ApplicationConfiguration contains Components fileds, how applicationConfiguration
-	// works with Components depends on implementor
-	// for _, compConf := range conf.Spec.Components {
-	// if handlers != nil {
-	// for _, h := range handlers {
-	// if err := h.Handle(actionCtx, &conf, &compConf, eType); err != nil {
-	// log.Error(err, "handler handle error", "handler id", h.Id())
-	// return ctrl.Result{}, err
-	// }
-	// }
-	// }
-	// }
-	for _, h := range handlers {
-		if err := h.Handle(actionCtx, conf, eType); err != nil {
-			log.Error(err, "handler handle error", "handler id", h.Id())
-			return ctrl.Result{}, err
-		}
+	blocked, err := r.handle(ctx, actionCtx, conf, eType, log)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	ac, isAppConf := conf.(*v1alpha1.ApplicationConfiguration)
+	if isAppConf {
+		r.runtime().phases.set(req.NamespacedName, ac.Status.Phase, eType == Delete)
 	}
 
 	// do handler related actions
@@ -89,7 +128,172 @@ func (r *Reconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	if isAppConf && eType != Delete && len(r.runtime().getComponentHandlers()) > 0 {
+		if err := r.updateBlockedComponents(ctx, ac, blocked); err != nil {
+			log.Error(err, "update blocked components error")
+			return ctrl.Result{}, err
+		}
+	}
+	if len(blocked) > 0 {
+		// retry until dependencies are ready
+		log.Info("components blocked by dependencies", "components", blocked)
+		actionCtx.RequeueAfter(blockedRequeueAfter)
+	}
+	return ctrl.Result{RequeueAfter: actionCtx.GetRequeueAfter()}, nil
+}
+
+// handle invokes the handlers of conf then, for ApplicationConfigurations, component handlers. It
+// returns the components blocked by their dependencies.
+func (r *Reconciler) handle(ctx context.Context, actionCtx *ActionContext, conf runtime.Object,
+	eType EType, log logr.Logger) ([]v1alpha1.BlockedComponent, error) {
+	for _, h := range r.runtime().getHandlers(r.specType) {
+		start := time.Now()
+		hctx, hspan := StartSpan(ctx, "Handle "+h.Id(), AttrHandler.String(h.Id()))
+		actionCtx.ctx = hctx
+		err := h.Handle(actionCtx, conf, eType)
+		actionCtx.ctx = ctx
+		endSpan(hspan, err)
+		observeHandler(r.specType, h.Id(), start, err)
+		if err != nil {
+			log.Error(err, "handler handle error", "handler id", h.Id())
+			actionCtx.Eventf(corev1.EventTypeWarning, EventReasonHandlerFailed, "handler %s: %v", h.Id(), err)
+			return nil, err
+		}
+	}
+
+	// invoke component handlers for ApplicationConfiguration
+	ac, isAppConf := conf.(*v1alpha1.ApplicationConfiguration)
+	if !isAppConf {
+		return nil, nil
+	}
+	blocked, err := r.handleComponents(ctx, actionCtx, ac, eType)
+	if err != nil {
+		log.Error(err, "component handler handle error")
+		actionCtx.Event(corev1.EventTypeWarning, EventReasonHandlerFailed, err.Error())
+		return nil, err
+	}
+	return blocked, nil
+}
+
+// handleComponents invokes component handlers in dependency order. A component is blocked, and not
+// handled, until all its dependencies are ready and its parameter values are available.
+func (r *Reconciler) handleComponents(ctx context.Context, actionCtx *ActionContext,
+	ac *v1alpha1.ApplicationConfiguration, eType EType) ([]v1alpha1.BlockedComponent, error) {
+	handlers := r.runtime().getComponentHandlers()
+	if len(handlers) == 0 {
+		return nil, nil
+	}
+	components, err := SortComponents(ac.Spec.Components)
+	if err != nil {
+		return nil, err
+	}
+
+	if eType == Delete {
+		// dependents go away before their dependencies
+		for i := len(components) - 1; i >= 0; i-- {
+			if err := invokeComponentHandlers(handlers, actionCtx, ac, &components[i], eType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	}
+
+	var blocked []v1alpha1.BlockedComponent
+	isBlocked := map[string]bool{}
+	for i := range components {
+		comp := &components[i]
+		b := checkDependencies(ac, comp, isBlocked, r.assumeReady)
+		if b == nil {
+			values, err := ResolveParameters(ctx, r, ac, comp)
+			switch {
+			case IsPending(err):
+				b = &v1alpha1.BlockedComponent{Reason: v1alpha1.ParameterPending, Message: err.Error()}
+			case err != nil:
+				return nil, err
+			default:
+				comp.ParameterValues = values
+			}
+		}
+		if b != nil {
+			b.ComponentName = comp.ComponentName
+			isBlocked[comp.ComponentName] = true
+			blocked = append(blocked, *b)
+			continue
+		}
+		pre, actions, post := len(actionCtx.PreActions), len(actionCtx.Actions), len(actionCtx.PostActions)
+		err := invokeComponentHandlers(handlers, actionCtx, ac, comp, eType)
+		switch {
+		case IsPending(err):
+			// a handler resolving values itself, actions of the component are dropped until they are available
+			actionCtx.PreActions, actionCtx.Actions, actionCtx.PostActions =
+				actionCtx.PreActions[:pre], actionCtx.Actions[:actions], actionCtx.PostActions[:post]
+			isBlocked[comp.ComponentName] = true
+			blocked = append(blocked, v1alpha1.BlockedComponent{
+				ComponentName: comp.ComponentName, Reason: v1alpha1.ParameterPending, Message: err.Error(),
+			})
+		case err != nil:
+			return nil, err
+		}
+	}
+	return blocked, nil
+}
+
+// checkDependencies returns why comp is blocked, or nil if all its dependencies are ready. Dependencies
+// are only required not to be blocked if assumeReady is set.
+func checkDependencies(ac *v1alpha1.ApplicationConfiguration, comp *v1alpha1.ComponentConfiguration,
+	isBlocked map[string]bool, assumeReady bool) *v1alpha1.BlockedComponent {
+	for _, dep := range comp.Dependencies() {
+		if isBlocked[dep] {
+			return &v1alpha1.BlockedComponent{
+				Reason:  v1alpha1.DependencyBlocked,
+				Message: fmt.Sprintf("dependency %s is blocked", dep),
+			}
+		}
+		if !assumeReady && !ac.Status.ComponentReady(dep) {
+			return &v1alpha1.BlockedComponent{
+				Reason:  v1alpha1.DependencyNotReady,
+				Message: fmt.Sprintf("waiting for dependency %s to be ready", dep),
+			}
+		}
+	}
+	return nil
+}
+
+// updateBlockedComponents records blocked components in ApplicationConfiguration status.
+// Only this field is patched so status written by handlers is kept.
+func (r *Reconciler) updateBlockedComponents(ctx context.Context, ac *v1alpha1.ApplicationConfiguration,
+	blocked []v1alpha1.BlockedComponent) error {
+	if equality.Semantic.DeepEqual(ac.Status.BlockedComponents, blocked) {
+		return nil
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{"blockedComponents": blocked},
+	})
+	if err != nil {
+		return err
+	}
+	return r.Status().Patch(ctx, ac, client.ConstantPatch(types.MergePatchType, patch))
+}
+
+func invokeComponentHandlers(handlers []ComponentHandler, actionCtx *ActionContext,
+	ac *v1alpha1.ApplicationConfiguration, comp *v1alpha1.ComponentConfiguration, eType EType) error {
+	ctx := actionCtx.Context()
+	defer func() { actionCtx.ctx = ctx }()
+	for _, h := range handlers {
+		start := time.Now()
+		hctx, hspan := StartSpan(ctx, "HandleComponent "+h.Id(), AttrHandler.String(h.Id()), AttrComponent.String(comp.ComponentName))
+		actionCtx.ctx = hctx
+		err := h.HandleComponent(actionCtx, ac, comp, eType)
+		endSpan(hspan, err)
+		observeHandler(STypeApplicationConfiguration, h.Id(), start, err)
+		if IsPending(err) {
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("component handler %s handle component %s error: %v", h.Id(), comp.ComponentName, err)
+		}
+	}
+	return nil
 }
 
 func (r *Reconciler) doActions(actionCtx *ActionContext, log logr.Logger) error {
@@ -99,26 +303,150 @@ func (r *Reconciler) doActions(actionCtx *ActionContext, log logr.Logger) error
 			// todo: process this panic.
 			panic("not support action provider:" + action.Provider)
 		}
-		robj := action.Plan.(runtime.Object)
-		switch action.Command {
-		case CmdTypeCreate:
-			if err := r.Create(context.Background(), robj); err != nil {
-				log.Error(err, "do create action error", "provider", "k8s", "plan", robj)
-				return err
-			}
-		case CmdTypeUpdate:
-			if err := r.Update(context.Background(), robj); err != nil {
-				log.Error(err, "do update action error", "provider", "k8s", "plan", robj)
-				return err
+		ctx, span := StartSpan(actionCtx.Context(), "Action "+string(action.Command), r.planAttributes(action)...)
+		err := r.doAction(ctx, actionCtx, action, log)
+		endSpan(span, err)
+		if err != nil {
+			return err
+		}
+		actionCtx.done = append(actionCtx.done, action)
+	}
+	return nil
+}
+
+func (r *Reconciler) doAction(ctx context.Context, actionCtx *ActionContext, action Action, log logr.Logger) error {
+	if pp, ok := action.Plan.(*PatchPlan); ok {
+		if err := r.doPatch(ctx, action.Command, pp); err != nil {
+			log.Error(err, "do patch action error", "provider", "k8s", "command", action.Command, "plan", pp.Object)
+			actionCtx.Eventf(corev1.EventTypeWarning, EventReasonActionFailed, "failed to patch %s: %v", describe(pp.Object), err)
+			observeAction(action, actionResultError)
+			return err
+		}
+		actionCtx.Eventf(corev1.EventTypeNormal, EventReasonPatched, "patched %s", describe(pp.Object))
+		observeAction(action, actionResultSuccess)
+		return nil
+	}
+	robj := action.Plan.(runtime.Object)
+	switch action.Command {
+	case CmdTypeCreate:
+		if err := r.Create(ctx, robj); err != nil {
+			log.Error(err, "do create action error", "provider", "k8s", "plan", robj)
+			actionCtx.Eventf(corev1.EventTypeWarning, EventReasonActionFailed, "failed to create %s: %v", describe(robj), err)
+			observeAction(action, actionResultError)
+			return err
+		}
+		actionCtx.Eventf(corev1.EventTypeNormal, EventReasonCreated, "created %s", describe(robj))
+		observeAction(action, actionResultSuccess)
+	case CmdTypeUpdate:
+		if r.unchanged(ctx, robj) {
+			actionCtx.Eventf(corev1.EventTypeNormal, EventReasonUnchanged, "%s unchanged, update skipped", describe(robj))
+			observeAction(action, actionResultUnchanged)
+			return nil
+		}
+		if err := r.Update(ctx, robj); err != nil {
+			log.Error(err, "do update action error", "provider", "k8s", "plan", robj)
+			actionCtx.Eventf(corev1.EventTypeWarning, EventReasonActionFailed, "failed to update %s: %v", describe(robj), err)
+			observeAction(action, actionResultError)
+			return err
+		}
+		actionCtx.Eventf(corev1.EventTypeNormal, EventReasonUpdated, "updated %s", describe(robj))
+		observeAction(action, actionResultSuccess)
+	case CmdTypeDelete:
+		if err := r.Delete(ctx, robj); err != nil {
+			log.Error(err, "do delete action error", "provider", "k8s", "plan", robj)
+			actionCtx.Eventf(corev1.EventTypeWarning, EventReasonActionFailed, "failed to delete %s: %v", describe(robj), err)
+			observeAction(action, actionResultError)
+			return err
+		}
+		actionCtx.Eventf(corev1.EventTypeNormal, EventReasonDeleted, "deleted %s", describe(robj))
+		observeAction(action, actionResultSuccess)
+	case CmdTypeUpdateStatus:
+		if err := r.Status().Update(ctx, robj); err != nil {
+			log.Error(err, "do update status action error", "provider", "k8s", "plan", robj)
+			actionCtx.Eventf(corev1.EventTypeWarning, EventReasonActionFailed, "failed to update status of %s: %v", describe(robj), err)
+			observeAction(action, actionResultError)
+			return err
+		}
+		actionCtx.Eventf(corev1.EventTypeNormal, EventReasonUpdated, "updated status of %s", describe(robj))
+		observeAction(action, actionResultSuccess)
+	}
+	return nil
+}
+
+// planAttributes returns span attributes describing the plan of action.
+func (r *Reconciler) planAttributes(action Action) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{AttrCommand.String(string(action.Command))}
+	robj, ok := action.Plan.(runtime.Object)
+	if pp, isPatch := action.Plan.(*PatchPlan); isPatch {
+		robj, ok = pp.Object, true
+	}
+	if !ok {
+		return attrs
+	}
+	attrs = append(attrs, AttrPlan.String(describe(robj)))
+	if gvk, ok := r.gvkFor(robj); ok {
+		attrs = append(attrs, AttrPlanGVK.String(gvk.String()))
+	}
+	return attrs
+}
+
+// gvkFor returns the GVK of robj, looked up in the scheme if not set.
+func (r *Reconciler) gvkFor(robj runtime.Object) (schema.GroupVersionKind, bool) {
+	gvk := robj.GetObjectKind().GroupVersionKind()
+	if gvk.Empty() && r.Scheme != nil {
+		var err error
+		if gvk, err = apiutil.GVKForObject(robj, r.Scheme); err != nil {
+			return gvk, false
+		}
+	}
+	return gvk, !gvk.Empty()
+}
+
+// serverFields are the metadata fields set by the API server, ignored when comparing objects.
+var serverFields = []string{"resourceVersion", "uid", "selfLink", "generation", "creationTimestamp",
+	"managedFields", "deletionTimestamp", "deletionGracePeriodSeconds"}
+
+// unchanged checks whether robj equals the live object, metadata fields set by the API server and status
+// are ignored. Objects which can't be compared are considered changed.
+func (r *Reconciler) unchanged(ctx context.Context, robj runtime.Object) bool {
+	desired, err := runtime.DefaultUnstructuredConverter.ToUnstructured(robj)
+	if err != nil {
+		return false
+	}
+	gvk, ok := r.gvkFor(robj)
+	accessor, err := meta.Accessor(robj)
+	if err != nil || !ok {
+		return false
+	}
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(gvk)
+	if err := r.Get(ctx, types.NamespacedName{Namespace: accessor.GetNamespace(), Name: accessor.GetName()}, live); err != nil {
+		return false
+	}
+	for _, obj := range []map[string]interface{}{desired, live.Object} {
+		for _, k := range []string{"apiVersion", "kind", "status"} {
+			delete(obj, k)
+		}
+		if m, ok := obj["metadata"].(map[string]interface{}); ok {
+			for _, k := range serverFields {
+				delete(m, k)
 			}
-		case CmdTypeDelete:
-			if err := r.Delete(context.Background(), robj); err != nil {
-				log.Error(err, "do delete action error", "provider", "k8s", "plan", robj)
-				return err
+			if len(m) == 0 {
+				delete(obj, "metadata")
 			}
 		}
 	}
-	return nil
+	return equality.Semantic.DeepEqual(desired, live.Object)
+}
+
+func (r *Reconciler) doPatch(ctx context.Context, cmd CmdType, pp *PatchPlan) error {
+	switch cmd {
+	case CmdTypePatch:
+		return r.Patch(ctx, pp.Object, pp.Patch)
+	case CmdTypePatchStatus:
+		return r.Status().Patch(ctx, pp.Object, pp.Patch)
+	}
+	return fmt.Errorf("command %s not supported for patch plan", cmd)
 }
 
 func (r *Reconciler) getOpCode(ctx context.Context,
@@ -146,13 +474,29 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	obj := r.specType.RuntimeObj()
 	bld := ctrl.NewControllerManagedBy(mgr).For(obj)
 
-	owns := getOwns(r.specType)
+	owns := r.runtime().getOwns(r.specType)
 	if owns != nil {
 		for _, o := range owns {
 			bld = bld.Owns(o)
 		}
 	}
-	controllerOptions := getControllerOption(r.specType)
+	watches := append([]watch(nil), r.runtime().getWatches(r.specType)...)
+	switch r.specType {
+	case STypeComponent:
+		if err := IndexWorkloadTypes(mgr.GetFieldIndexer()); err != nil {
+			return err
+		}
+	case STypeApplicationConfiguration:
+		// reconcile ApplicationConfigurations again when objects they reference change
+		if err := IndexReferences(mgr.GetFieldIndexer()); err != nil {
+			return err
+		}
+		watches = append(watches, referenceWatches(mgr.GetClient())...)
+	}
+	for _, w := range watches {
+		bld = bld.Watches(w.source, w.handler)
+	}
+	controllerOptions := r.runtime().getControllerOption(r.specType)
 	bld = bld.WithOptions(controllerOptions)
 
 	return bld.Complete(r)
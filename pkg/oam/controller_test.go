@@ -0,0 +1,108 @@
+package oam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/apis/flags"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type recordComponentHandler struct {
+	handled []string
+}
+
+func (h *recordComponentHandler) Id() string { return "record" }
+
+func (h *recordComponentHandler) HandleComponent(ctx *ActionContext, ac *v1alpha1.ApplicationConfiguration,
+	comp *v1alpha1.ComponentConfiguration, eType EType) error {
+	h.handled = append(h.handled, comp.ComponentName)
+	return nil
+}
+
+func TestHandleComponents(t *testing.T) {
+	h := &recordComponentHandler{}
+	rt := newRuntime()
+	rt.RegisterComponentHandlers(h)
+
+	svc := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db",
+			Namespace: "default",
+			Labels:    map[string]string{v1alpha1.LabelComponent: "db"},
+		},
+		Spec: corev1.ServiceSpec{ClusterIP: "10.0.0.1"},
+	}
+	r := &Reconciler{Client: fake.NewFakeClientWithScheme(scheme.Scheme, svc), Runtime: rt}
+	web := componentFrom("web")
+	web.DependsOn = []string{"api"}
+	ac := &v1alpha1.ApplicationConfiguration{
+		Spec: v1alpha1.ApplicationConfigurationSpec{
+			Components: []v1alpha1.ComponentConfiguration{web, componentFrom("api", "db"), componentFrom("db")},
+		},
+	}
+
+	blocked, err := r.handleComponents(context.Background(), &ActionContext{}, ac, CreateOrUpdate)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"db"}, h.handled)
+	assert.Equal(t, []v1alpha1.BlockedComponent{
+		{ComponentName: "api", Reason: v1alpha1.DependencyNotReady, Message: "waiting for dependency db to be ready"},
+		{ComponentName: "web", Reason: v1alpha1.DependencyBlocked, Message: "dependency api is blocked"},
+	}, blocked)
+
+	h.handled = nil
+	ac.Status.Update([]metav1.Object{svc}, nil)
+	assert.Equal(t, flags.StatusReady, ac.Status.Modules[0].Status)
+	blocked, err = r.handleComponents(context.Background(), &ActionContext{}, ac, CreateOrUpdate)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"db", "api"}, h.handled)
+	assert.Equal(t, "web", blocked[0].ComponentName)
+	assert.Equal(t, v1alpha1.DependencyNotReady, blocked[0].Reason)
+
+	h.handled = nil
+	blocked, err = r.handleComponents(context.Background(), &ActionContext{}, ac, Delete)
+	assert.NoError(t, err)
+	assert.Empty(t, blocked)
+	assert.Equal(t, []string{"web", "api", "db"}, h.handled)
+}
+
+type pendingComponentHandler struct{}
+
+func (h *pendingComponentHandler) Id() string { return "pending" }
+
+func (h *pendingComponentHandler) HandleComponent(ctx *ActionContext, ac *v1alpha1.ApplicationConfiguration,
+	comp *v1alpha1.ComponentConfiguration, eType EType) error {
+	ctx.Add(Action{Provider: PTypeK8S, Command: CmdTypeCreate, Plan: &corev1.Service{}})
+	if comp.ComponentName == "web" {
+		return &PendingError{Component: "db", Parameter: "host", Reason: "component has no module yet"}
+	}
+	return nil
+}
+
+func TestHandleComponentsPending(t *testing.T) {
+	rt := newRuntime()
+	rt.RegisterComponentHandlers(&pendingComponentHandler{})
+	r := &Reconciler{Client: fake.NewFakeClientWithScheme(scheme.Scheme), Runtime: rt, assumeReady: true}
+	ac := &v1alpha1.ApplicationConfiguration{
+		Spec: v1alpha1.ApplicationConfigurationSpec{
+			Components: []v1alpha1.ComponentConfiguration{componentFrom("db"), componentFrom("web")},
+		},
+	}
+
+	actionCtx := &ActionContext{}
+	blocked, err := r.handleComponents(context.Background(), actionCtx, ac, CreateOrUpdate)
+	assert.NoError(t, err)
+	assert.Equal(t, []v1alpha1.BlockedComponent{{
+		ComponentName: "web",
+		Reason:        v1alpha1.ParameterPending,
+		Message:       `parameter "host" is pending on component "db": component has no module yet`,
+	}}, blocked)
+	// actions of the blocked component are dropped
+	assert.Len(t, actionCtx.Gather(), 1)
+}
@@ -0,0 +1,27 @@
+package oam
+
+import (
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha2"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// WithConversionWebhook serves the conversion webhook of ApplicationConfigurations on /convert of the
+// manager webhook server, so they are served as v1alpha1 and v1alpha2 and stored as v1alpha1. Both
+// versions are added to the manager scheme. The webhook server is configured by the Port and CertDir
+// manager options.
+func WithConversionWebhook() Option {
+	return func(rt *Runtime) error {
+		mgr := rt.GetMgr()
+		if mgr == nil {
+			return errNoManager
+		}
+		for _, add := range []func(*runtime.Scheme) error{v1alpha1.AddToScheme, v1alpha2.AddToScheme} {
+			if err := add(mgr.GetScheme()); err != nil {
+				return err
+			}
+		}
+		return ctrl.NewWebhookManagedBy(mgr).For(&v1alpha2.ApplicationConfiguration{}).Complete()
+	}
+}
@@ -0,0 +1,76 @@
+package oam
+
+import (
+	"fmt"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+)
+
+// DependencyError means components can't be sorted in dependency order, because of a dependency cycle or
+// a dependency on an unknown component.
+type DependencyError struct {
+	Component string
+	// Unknown is the dependency of Component that doesn't exist, empty for a cycle through Component.
+	Unknown string
+}
+
+func (e *DependencyError) Error() string {
+	if e.Unknown == "" {
+		return fmt.Sprintf("dependency cycle detected at component %q", e.Component)
+	}
+	return fmt.Sprintf("component %q depends on unknown component %q", e.Component, e.Unknown)
+}
+
+// IsDependencyCycle checks whether err is a DependencyError for a dependency cycle.
+func IsDependencyCycle(err error) bool {
+	e, ok := err.(*DependencyError)
+	return ok && e.Unknown == ""
+}
+
+// SortComponents returns components in dependency order: a component comes after every component
+// it depends on. Declaration order is kept for independent components. A DependencyError is returned
+// if they can't be sorted.
+func SortComponents(components []v1alpha1.ComponentConfiguration) ([]v1alpha1.ComponentConfiguration, error) {
+	index := make(map[string]int, len(components))
+	for i, c := range components {
+		index[c.ComponentName] = i
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(components))
+	sorted := make([]v1alpha1.ComponentConfiguration, 0, len(components))
+
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch state[i] {
+		case visited:
+			return nil
+		case visiting:
+			return &DependencyError{Component: components[i].ComponentName}
+		}
+		state[i] = visiting
+		for _, dep := range components[i].Dependencies() {
+			j, ok := index[dep]
+			if !ok {
+				return &DependencyError{Component: components[i].ComponentName, Unknown: dep}
+			}
+			if err := visit(j); err != nil {
+				return err
+			}
+		}
+		state[i] = visited
+		sorted = append(sorted, components[i])
+		return nil
+	}
+
+	for i := range components {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
@@ -0,0 +1,58 @@
+package oam
+
+import (
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func componentFrom(name string, deps ...string) v1alpha1.ComponentConfiguration {
+	c := v1alpha1.ComponentConfiguration{ComponentName: name, InstanceName: name}
+	for _, d := range deps {
+		c.ParameterValues = append(c.ParameterValues, v1alpha1.ParameterValue{
+			Name: d + "-addr",
+			From: &v1alpha1.ParameterFrom{Component: d, FieldPath: ".spec.clusterIP"},
+		})
+	}
+	return c
+}
+
+func names(components []v1alpha1.ComponentConfiguration) []string {
+	var n []string
+	for _, c := range components {
+		n = append(n, c.ComponentName)
+	}
+	return n
+}
+
+func TestSortComponents(t *testing.T) {
+	sorted, err := SortComponents([]v1alpha1.ComponentConfiguration{
+		componentFrom("web", "api"),
+		componentFrom("api", "db", "cache"),
+		componentFrom("db"),
+		componentFrom("cache"),
+		componentFrom("worker"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"db", "cache", "api", "web", "worker"}, names(sorted))
+
+	_, err = SortComponents([]v1alpha1.ComponentConfiguration{
+		componentFrom("a", "b"),
+		componentFrom("b", "a"),
+	})
+	assert.EqualError(t, err, `dependency cycle detected at component "a"`)
+	assert.True(t, IsDependencyCycle(err))
+
+	_, err = SortComponents([]v1alpha1.ComponentConfiguration{componentFrom("a", "missing")})
+	assert.Equal(t, &DependencyError{Component: "a", Unknown: "missing"}, err)
+	assert.False(t, IsDependencyCycle(err))
+}
+
+func TestSortComponentsDependsOn(t *testing.T) {
+	web := componentFrom("web")
+	web.DependsOn = []string{"db"}
+	sorted, err := SortComponents([]v1alpha1.ComponentConfiguration{web, componentFrom("db")})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"db", "web"}, names(sorted))
+}
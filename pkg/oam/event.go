@@ -0,0 +1,101 @@
+package oam
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// Reasons of events recorded on OAM objects.
+const (
+	EventReasonCreated       = "Created"
+	EventReasonUpdated       = "Updated"
+	EventReasonDeleted       = "Deleted"
+	EventReasonPatched       = "Patched"
+	EventReasonUnchanged     = "Unchanged"
+	EventReasonActionFailed  = "ActionFailed"
+	EventReasonHandlerFailed = "HandlerFailed"
+)
+
+// DefaultEventInterval is the minimum interval between equal events of an object.
+const DefaultEventInterval = time.Minute
+
+// rateLimitedRecorder drops events equal to one recorded on the same object less than interval ago,
+// so reconciling an object in a loop doesn't flood the event stream.
+type rateLimitedRecorder struct {
+	record.EventRecorder
+	interval time.Duration
+	now      func() time.Time
+
+	l    sync.Mutex
+	last map[string]time.Time
+}
+
+// NewRateLimitedRecorder returns a recorder dropping events equal to one recorded on the same object
+// less than interval ago.
+func NewRateLimitedRecorder(recorder record.EventRecorder, interval time.Duration) record.EventRecorder {
+	return &rateLimitedRecorder{EventRecorder: recorder, interval: interval, now: time.Now, last: map[string]time.Time{}}
+}
+
+func (r *rateLimitedRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if r.allow(object, eventtype, reason, message) {
+		r.EventRecorder.Event(object, eventtype, reason, message)
+	}
+}
+
+func (r *rateLimitedRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *rateLimitedRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string,
+	eventtype, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if r.allow(object, eventtype, reason, message) {
+		r.EventRecorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+	}
+}
+
+func (r *rateLimitedRecorder) allow(object runtime.Object, eventtype, reason, message string) bool {
+	key := eventtype + "/" + reason + "/" + message
+	if obj, err := meta.Accessor(object); err == nil {
+		key = string(obj.GetUID()) + "/" + obj.GetNamespace() + "/" + obj.GetName() + "/" + key
+	}
+	now := r.now()
+
+	r.l.Lock()
+	defer r.l.Unlock()
+	if last, ok := r.last[key]; ok && now.Sub(last) < r.interval {
+		return false
+	}
+	r.last[key] = now
+	if len(r.last) > 1024 {
+		// forget events out of the interval
+		for k, t := range r.last {
+			if now.Sub(t) >= r.interval {
+				delete(r.last, k)
+			}
+		}
+	}
+	return true
+}
+
+// describe returns kind and namespaced name of obj for event messages.
+func describe(obj runtime.Object) string {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if kind == "" {
+		kind = reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return kind
+	}
+	if accessor.GetNamespace() == "" {
+		return kind + " " + accessor.GetName()
+	}
+	return kind + " " + accessor.GetNamespace() + "/" + accessor.GetName()
+}
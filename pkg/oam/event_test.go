@@ -0,0 +1,84 @@
+package oam
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRateLimitedRecorder(t *testing.T) {
+	fakeRecorder := record.NewFakeRecorder(10)
+	now := time.Now()
+	recorder := NewRateLimitedRecorder(fakeRecorder, time.Minute).(*rateLimitedRecorder)
+	recorder.now = func() time.Time { return now }
+	ac := &v1alpha1.ApplicationConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+
+	recorder.Event(ac, corev1.EventTypeNormal, EventReasonCreated, "created Service default/web")
+	recorder.Event(ac, corev1.EventTypeNormal, EventReasonCreated, "created Service default/web")
+	recorder.Event(ac, corev1.EventTypeNormal, EventReasonCreated, "created Service default/db")
+	now = now.Add(time.Minute)
+	recorder.Eventf(ac, corev1.EventTypeNormal, EventReasonCreated, "created Service %s", "default/web")
+	close(fakeRecorder.Events)
+	var events []string
+	for e := range fakeRecorder.Events {
+		events = append(events, e)
+	}
+	assert.Equal(t, []string{
+		"Normal Created created Service default/web",
+		"Normal Created created Service default/db",
+		"Normal Created created Service default/web",
+	}, events)
+}
+
+func TestDoActionsEvents(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.0.0.1"},
+	}
+	r := &Reconciler{Client: fake.NewFakeClientWithScheme(scheme.Scheme), Scheme: scheme.Scheme}
+	recorder := record.NewFakeRecorder(10)
+	ac := &v1alpha1.ApplicationConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	actionCtx := NewActionContext(ac, recorder)
+
+	actionCtx.Add(Action{Provider: PTypeK8S, Command: CmdTypeCreate, Plan: svc.DeepCopy()})
+	assert.NoError(t, r.doActions(actionCtx, ctrl.Log))
+	assert.Equal(t, "Normal Created created Service default/web", <-recorder.Events)
+
+	// an update without change is skipped
+	actionCtx.Add(Action{Provider: PTypeK8S, Command: CmdTypeUpdate, Plan: svc.DeepCopy()})
+	assert.NoError(t, r.doActions(actionCtx, ctrl.Log))
+	assert.Equal(t, "Normal Unchanged Service default/web unchanged, update skipped", <-recorder.Events)
+
+	changed := svc.DeepCopy()
+	changed.Labels = map[string]string{"app": "web"}
+	actionCtx.Add(Action{Provider: PTypeK8S, Command: CmdTypeUpdate, Plan: changed})
+	assert.NoError(t, r.doActions(actionCtx, ctrl.Log))
+	assert.Equal(t, "Normal Updated updated Service default/web", <-recorder.Events)
+
+	// finalizers are compared too, removing one must reach the API server
+	finalized := changed.DeepCopy()
+	finalized.Finalizers = []string{"core.oam.dev/finalizer"}
+	actionCtx.Add(Action{Provider: PTypeK8S, Command: CmdTypeUpdate, Plan: finalized.DeepCopy()})
+	assert.NoError(t, r.doActions(actionCtx, ctrl.Log))
+	assert.Equal(t, "Normal Updated updated Service default/web", <-recorder.Events)
+	actionCtx.Add(Action{Provider: PTypeK8S, Command: CmdTypeUpdate, Plan: changed.DeepCopy()})
+	assert.NoError(t, r.doActions(actionCtx, ctrl.Log))
+	assert.Equal(t, "Normal Updated updated Service default/web", <-recorder.Events)
+	live := &corev1.Service{}
+	assert.NoError(t, r.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "web"}, live))
+	assert.Empty(t, live.Finalizers)
+
+	actionCtx.Add(Action{Provider: PTypeK8S, Command: CmdTypeCreate, Plan: svc.DeepCopy()})
+	assert.Error(t, r.doActions(actionCtx, ctrl.Log))
+	assert.Contains(t, <-recorder.Events, "Warning ActionFailed failed to create Service default/web")
+}
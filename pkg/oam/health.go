@@ -0,0 +1,243 @@
+package oam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+// Names of the checks served on the health probe endpoints, see WithHealthProbes.
+const (
+	HealthzPing     = "ping"
+	ReadyzInformers = "informers"
+	ReadyzHandlers  = "handlers"
+)
+
+// DefaultLeaderID is the name of the leader election lock, see WithLeaderElection.
+const DefaultLeaderID = "oam-controller-leader-election"
+
+// DefaultProbesAddr is the address health probes are served on, see WithHealthProbes.
+const DefaultProbesAddr = ":8081"
+
+// DefaultShutdownTimeout is the time given to in-flight reconciles and shutdown hooks once a runtime is stopped.
+const DefaultShutdownTimeout = 30 * time.Second
+
+// ManagerOption configures the manager of a runtime, see New and InitMgr.
+type ManagerOption func(options *ctrl.Options)
+
+// WithLeaderElection runs reconcilers only in the replica holding the lock id in namespace. An empty id is
+// DefaultLeaderID, an empty namespace is the namespace the controller runs in.
+func WithLeaderElection(id, namespace string) ManagerOption {
+	return func(options *ctrl.Options) {
+		if id == "" {
+			id = DefaultLeaderID
+		}
+		options.LeaderElection = true
+		options.LeaderElectionID = id
+		options.LeaderElectionNamespace = namespace
+	}
+}
+
+// WithHealthProbes serves /healthz and /readyz on addr, DefaultProbesAddr if empty. The runtime is ready
+// once informers are synced and all handlers implementing HealthChecker are healthy.
+func WithHealthProbes(addr string) ManagerOption {
+	return func(options *ctrl.Options) {
+		if addr == "" {
+			addr = DefaultProbesAddr
+		}
+		options.HealthProbeBindAddress = addr
+	}
+}
+
+// HealthChecker is implemented by handlers reporting their health, an unhealthy handler makes the runtime
+// not ready.
+type HealthChecker interface {
+	CheckHealth() error
+}
+
+// ShutdownHook is called once a runtime is stopped and in-flight reconciles are done, ctx expires with the
+// shutdown timeout. Handlers use it to flush pending work.
+type ShutdownHook func(ctx context.Context) error
+
+var errNotSynced = errors.New("informers not synced")
+
+// addChecks registers health checks of the runtime in its manager.
+func (rt *Runtime) addChecks() error {
+	var synced syncedFlag
+	err := rt.mgr.Add(&syncRunnable{mgr: rt.mgr, synced: &synced})
+	if err != nil {
+		return err
+	}
+	if err := rt.mgr.AddHealthzCheck(HealthzPing, healthz.Ping); err != nil {
+		return err
+	}
+	if err := rt.mgr.AddReadyzCheck(ReadyzInformers, func(_ *http.Request) error {
+		if !synced.get() {
+			return errNotSynced
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return rt.mgr.AddReadyzCheck(ReadyzHandlers, func(_ *http.Request) error {
+		return rt.checkHandlers()
+	})
+}
+
+// checkHandlers returns the errors of unhealthy handlers.
+func (rt *Runtime) checkHandlers() error {
+	rt.l.RLock()
+	var checkers []HealthChecker
+	for _, hs := range rt.handlers {
+		for _, h := range hs {
+			if c, ok := h.(HealthChecker); ok {
+				checkers = append(checkers, c)
+			}
+		}
+	}
+	for _, h := range rt.componentHandlers {
+		if c, ok := h.(HealthChecker); ok {
+			checkers = append(checkers, c)
+		}
+	}
+	rt.l.RUnlock()
+
+	var msgs []string
+	for _, c := range checkers {
+		if err := c.CheckHealth(); err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+	if len(msgs) > 0 {
+		return fmt.Errorf("unhealthy handlers: %s", strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
+// OnShutdown registers hooks called once the runtime is stopped, in registration order.
+func (rt *Runtime) OnShutdown(hooks ...ShutdownHook) {
+	rt.l.Lock()
+	defer rt.l.Unlock()
+	rt.shutdownHooks = append(rt.shutdownHooks, hooks...)
+}
+
+// SetShutdownTimeout sets the time given to in-flight reconciles and shutdown hooks, DefaultShutdownTimeout
+// if not set.
+func (rt *Runtime) SetShutdownTimeout(d time.Duration) {
+	rt.l.Lock()
+	defer rt.l.Unlock()
+	rt.shutdownTimeout = d
+}
+
+// shutdown waits for in-flight reconciles then calls shutdown hooks, within the shutdown timeout.
+func (rt *Runtime) shutdown() error {
+	rt.l.RLock()
+	timeout, hooks := rt.shutdownTimeout, rt.shutdownHooks
+	rt.l.RUnlock()
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	oamLog.Info("waiting for in-flight reconciles")
+	if err := rt.inflight.wait(ctx); err != nil {
+		oamLog.Error(err, "in-flight reconciles not done")
+	}
+	var msgs []string
+	for _, hook := range hooks {
+		if err := hook(ctx); err != nil {
+			oamLog.Error(err, "shutdown hook error")
+			msgs = append(msgs, err.Error())
+		}
+	}
+	if len(msgs) > 0 {
+		return fmt.Errorf("shutdown hooks failed: %s", strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
+// syncRunnable marks informers synced. Started by the manager once its cache is synced, it runs in every
+// replica, leader or not.
+type syncRunnable struct {
+	mgr    ctrl.Manager
+	synced *syncedFlag
+}
+
+func (s *syncRunnable) Start(stop <-chan struct{}) error {
+	if s.mgr.GetCache().WaitForCacheSync(stop) {
+		s.synced.set()
+	}
+	<-stop
+	return nil
+}
+
+func (s *syncRunnable) NeedLeaderElection() bool {
+	return false
+}
+
+type syncedFlag struct {
+	l      sync.RWMutex
+	synced bool
+}
+
+func (f *syncedFlag) set() {
+	f.l.Lock()
+	defer f.l.Unlock()
+	f.synced = true
+}
+
+func (f *syncedFlag) get() bool {
+	f.l.RLock()
+	defer f.l.RUnlock()
+	return f.synced
+}
+
+// inflight counts reconciles in progress.
+type inflight struct {
+	l    sync.Mutex
+	n    int
+	idle chan struct{}
+}
+
+func (f *inflight) add() {
+	f.l.Lock()
+	defer f.l.Unlock()
+	if f.n == 0 {
+		f.idle = make(chan struct{})
+	}
+	f.n++
+}
+
+func (f *inflight) done() {
+	f.l.Lock()
+	defer f.l.Unlock()
+	f.n--
+	if f.n == 0 {
+		close(f.idle)
+	}
+}
+
+// wait waits until no reconcile is in progress or ctx is done.
+func (f *inflight) wait(ctx context.Context) error {
+	f.l.Lock()
+	if f.n == 0 {
+		f.l.Unlock()
+		return nil
+	}
+	idle := f.idle
+	f.l.Unlock()
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,71 @@
+package oam
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type checkedHandler struct {
+	err error
+}
+
+func (h *checkedHandler) Id() string { return "checked" }
+
+func (h *checkedHandler) Handle(ctx *ActionContext, obj runtime.Object, eType EType) error { return nil }
+
+func (h *checkedHandler) CheckHealth() error { return h.err }
+
+func TestManagerOptions(t *testing.T) {
+	options := ctrl.Options{}
+	WithLeaderElection("", "oam-system")(&options)
+	WithHealthProbes("")(&options)
+	assert.True(t, options.LeaderElection)
+	assert.Equal(t, DefaultLeaderID, options.LeaderElectionID)
+	assert.Equal(t, "oam-system", options.LeaderElectionNamespace)
+	assert.Equal(t, DefaultProbesAddr, options.HealthProbeBindAddress)
+}
+
+func TestCheckHandlers(t *testing.T) {
+	rt := newRuntime()
+	h := &checkedHandler{}
+	rt.RegisterHandlers(STypeComponent, h, idHandler("unchecked"))
+	assert.NoError(t, rt.checkHandlers())
+
+	h.err = errors.New("queue full")
+	assert.EqualError(t, rt.checkHandlers(), "unhealthy handlers: queue full")
+}
+
+func TestShutdown(t *testing.T) {
+	rt := newRuntime()
+	rt.SetShutdownTimeout(50 * time.Millisecond)
+	var calls []string
+	rt.OnShutdown(func(ctx context.Context) error {
+		calls = append(calls, "flush")
+		return nil
+	}, func(ctx context.Context) error {
+		calls = append(calls, "close")
+		return errors.New("closed twice")
+	})
+
+	// shutdown waits for in-flight reconciles
+	rt.inflight.add()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		calls = append(calls, "reconciled")
+		rt.inflight.done()
+	}()
+	assert.EqualError(t, rt.shutdown(), "shutdown hooks failed: closed twice")
+	assert.Equal(t, []string{"reconciled", "flush", "close"}, calls)
+
+	// up to the shutdown timeout
+	rt.inflight.add()
+	start := time.Now()
+	assert.Error(t, rt.shutdown())
+	assert.True(t, time.Since(start) >= 50*time.Millisecond)
+}
@@ -0,0 +1,88 @@
+package oam
+
+import (
+	"sync"
+	"time"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Results of actions in metrics.
+const (
+	actionResultSuccess   = "success"
+	actionResultError     = "error"
+	actionResultUnchanged = "unchanged"
+)
+
+var (
+	handlerDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "oam_handler_duration_seconds",
+		Help: "Duration of handler invocations by spec type and handler id.",
+	}, []string{"spec_type", "handler"})
+
+	handlerErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "oam_handler_errors_total",
+		Help: "Number of handler invocations returning an error by spec type and handler id.",
+	}, []string{"spec_type", "handler"})
+
+	actionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "oam_actions_total",
+		Help: "Number of actions done by provider, command and result.",
+	}, []string{"provider", "command", "result"})
+
+	applicationPhase = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "oam_application_phase",
+		Help: "Number of ApplicationConfigurations by namespace and phase.",
+	}, []string{"namespace", "phase"})
+)
+
+func init() {
+	// served on the metrics endpoint of the manager
+	metrics.Registry.MustRegister(handlerDuration, handlerErrors, actionsTotal, applicationPhase)
+}
+
+// observeHandler records duration and error of a handler invocation started at start.
+func observeHandler(tp SType, id string, start time.Time, err error) {
+	handlerDuration.WithLabelValues(string(tp), id).Observe(time.Since(start).Seconds())
+	if err != nil {
+		handlerErrors.WithLabelValues(string(tp), id).Inc()
+	}
+}
+
+func observeAction(action Action, result string) {
+	actionsTotal.WithLabelValues(string(action.Provider), string(action.Command), result).Inc()
+}
+
+// phaseTracker keeps the phase of every ApplicationConfiguration reconciled by a runtime to count them
+// by phase.
+type phaseTracker struct {
+	l      sync.Mutex
+	phases map[types.NamespacedName]v1alpha1.ApplicationPhase
+}
+
+func newPhaseTracker() *phaseTracker {
+	return &phaseTracker{phases: map[types.NamespacedName]v1alpha1.ApplicationPhase{}}
+}
+
+// set records the phase of the named ApplicationConfiguration, deleted ones are forgotten.
+func (t *phaseTracker) set(name types.NamespacedName, phase v1alpha1.ApplicationPhase, deleted bool) {
+	if phase == "" {
+		phase = v1alpha1.ApplicationProgressing
+	}
+	t.l.Lock()
+	defer t.l.Unlock()
+	if old, ok := t.phases[name]; ok {
+		if old == phase && !deleted {
+			return
+		}
+		applicationPhase.WithLabelValues(name.Namespace, string(old)).Dec()
+		delete(t.phases, name)
+	}
+	if !deleted {
+		t.phases[name] = phase
+		applicationPhase.WithLabelValues(name.Namespace, string(phase)).Inc()
+	}
+}
@@ -0,0 +1,29 @@
+package oam
+
+import (
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestPhaseTracker(t *testing.T) {
+	applicationPhase.Reset()
+	tracker := newPhaseTracker()
+	web := types.NamespacedName{Namespace: "default", Name: "web"}
+	db := types.NamespacedName{Namespace: "default", Name: "db"}
+
+	tracker.set(web, "", false)
+	tracker.set(db, v1alpha1.ApplicationProgressing, false)
+	assert.Equal(t, float64(2), testutil.ToFloat64(applicationPhase.WithLabelValues("default", "Progressing")))
+
+	tracker.set(web, v1alpha1.ApplicationReady, false)
+	tracker.set(web, v1alpha1.ApplicationReady, false)
+	assert.Equal(t, float64(1), testutil.ToFloat64(applicationPhase.WithLabelValues("default", "Progressing")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(applicationPhase.WithLabelValues("default", "Ready")))
+
+	tracker.set(web, "", true)
+	assert.Equal(t, float64(0), testutil.ToFloat64(applicationPhase.WithLabelValues("default", "Ready")))
+}
@@ -0,0 +1,145 @@
+package oamtest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	"github.com/oam-dev/oam-go-sdk/pkg/render"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Update rewrites golden files instead of comparing them. Tests set it, usually from a flag of their
+// test binary:
+//
+//	func init() {
+//		flag.BoolVar(&oamtest.Update, "update", false, "rewrite golden files")
+//	}
+var Update bool
+
+// Fixture returns a Harness holding the objects of the YAML or JSON files at paths, read with render.Load.
+// Objects without namespace are put in render.DefaultNamespace, scheme is defaulted as by New.
+func Fixture(scheme *runtime.Scheme, paths ...string) (*Harness, error) {
+	h := New(scheme)
+	objs, err := render.Load(h.Scheme, paths...)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		m, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		if m.GetNamespace() == "" {
+			m.SetNamespace(render.DefaultNamespace)
+		}
+		if err := h.Client.Create(context.Background(), obj); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+// ReconcileApplications reconciles every ApplicationConfiguration of the harness, in namespace and name
+// order, and returns the actions done.
+func (h *Harness) ReconcileApplications() ([]oam.Action, error) {
+	list := &v1alpha1.ApplicationConfigurationList{}
+	if err := h.Client.List(context.Background(), list); err != nil {
+		return nil, err
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		a, b := list.Items[i], list.Items[j]
+		return a.Namespace < b.Namespace || (a.Namespace == b.Namespace && a.Name < b.Name)
+	})
+	var actions []oam.Action
+	for _, ac := range list.Items {
+		res, err := h.Reconcile(oam.STypeApplicationConfiguration, ac.Namespace, ac.Name)
+		if err != nil {
+			return nil, fmt.Errorf("reconcile %s/%s: %v", ac.Namespace, ac.Name, err)
+		}
+		actions = append(actions, res.Actions...)
+	}
+	return actions, nil
+}
+
+// Golden reconciles the ApplicationConfigurations of h and compares the actions done, as written by
+// render.MarshalActions, to the golden file.
+func Golden(t testing.TB, h *Harness, golden string) {
+	t.Helper()
+	actions, err := h.ReconcileApplications()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := render.MarshalActions(h.Scheme, actions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertGolden(t, golden, data)
+}
+
+// AssertGolden compares data to the content of the golden file, the file is rewritten with data instead
+// if Update is set.
+func AssertGolden(t testing.TB, golden string, data []byte) {
+	t.Helper()
+	if Update {
+		if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(golden, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("%v, set oamtest.Update to write it", err)
+	}
+	if !bytes.Equal(want, data) {
+		t.Errorf("%s differs, set oamtest.Update to rewrite it:\n%s", golden, diff(string(want), string(data)))
+	}
+}
+
+// diff returns the lines removed from want, prefixed with "-", and added to got, prefixed with "+".
+func diff(want, got string) string {
+	a, b := strings.Split(want, "\n"), strings.Split(got, "\n")
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	var out strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i, j = i+1, j+1
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			fmt.Fprintf(&out, "-%s\n", a[i])
+			i++
+		default:
+			fmt.Fprintf(&out, "+%s\n", b[j])
+			j++
+		}
+	}
+	return out.String()
+}
@@ -0,0 +1,27 @@
+package oamtest
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	flag.BoolVar(&Update, "update", false, "rewrite golden files")
+}
+
+func TestGolden(t *testing.T) {
+	h, err := Fixture(nil, "testdata/app.yaml")
+	assert.NoError(t, err)
+	handler := &configHandler{}
+	h.Runtime.RegisterHandlers(oam.STypeApplicationConfiguration, handler)
+	h.Runtime.RegisterComponentHandlers(handler)
+	Golden(t, h, "testdata/app.golden.yaml")
+}
+
+func TestDiff(t *testing.T) {
+	assert.Equal(t, "-b\n+c\n+d\n", diff("a\nb\ne", "a\nc\nd\ne"))
+	assert.Empty(t, diff("a\nb", "a\nb"))
+}
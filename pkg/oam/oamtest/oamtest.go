@@ -0,0 +1,127 @@
+// Package oamtest runs the reconcilers of a Runtime against an in-memory client, so handlers are tested
+// in unit tests without cluster.
+package oamtest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// Harness reconciles objects with the handlers of Runtime, reading and writing them with Client.
+type Harness struct {
+	Runtime *oam.Runtime
+	// Client serves the objects of the harness, handlers and tests read them with it.
+	Client client.Client
+	Scheme *runtime.Scheme
+	// Recorder records the events of reconciles.
+	Recorder *Recorder
+}
+
+// New returns a Harness with a new Runtime and an in-memory client holding objs. Objects are decoded with
+// the types of scheme, Kubernetes and v1alpha1 types if nil.
+func New(scheme *runtime.Scheme, objs ...runtime.Object) *Harness {
+	if scheme == nil {
+		scheme = runtime.NewScheme()
+		_ = clientgoscheme.AddToScheme(scheme)
+		_ = v1alpha1.AddToScheme(scheme)
+	}
+	rt, _ := oam.New(nil, ctrl.Options{})
+	return &Harness{
+		Runtime:  rt,
+		Client:   fake.NewFakeClientWithScheme(scheme, objs...),
+		Scheme:   scheme,
+		Recorder: new(Recorder),
+	}
+}
+
+// Result is the result of a reconcile.
+type Result struct {
+	ctrl.Result
+	// Context is the ActionContext given to handlers.
+	Context *oam.ActionContext
+	// Actions are the actions done, in order.
+	Actions []oam.Action
+	// Events are the events recorded, as "<type> <reason> <message>".
+	Events []string
+}
+
+// Reconcile reconciles the object of spec type tp named namespace/name, as the controller would on an
+// event. Actions planned by handlers are done with Client.
+func (h *Harness) Reconcile(tp oam.SType, namespace, name string) (*Result, error) {
+	res := &Result{}
+	r := oam.NewReconciler(h.Runtime, tp, h.Client, h.Scheme)
+	r.Recorder = h.Recorder
+	r.ActionContextFunc = func(obj runtime.Object, recorder record.EventRecorder) *oam.ActionContext {
+		res.Context = oam.NewActionContext(obj, recorder)
+		return res.Context
+	}
+	events := len(h.Recorder.Events())
+	var err error
+	res.Result, err = r.Reconcile(ctrl.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}})
+	if res.Context != nil {
+		res.Actions = res.Context.Done()
+	}
+	res.Events = h.Recorder.Events()[events:]
+	return res, err
+}
+
+// ReconcileApplication reconciles the ApplicationConfiguration namespace/name and returns it as written
+// by the reconcile.
+func (h *Harness) ReconcileApplication(namespace, name string) (*v1alpha1.ApplicationConfiguration, *Result, error) {
+	res, err := h.Reconcile(oam.STypeApplicationConfiguration, namespace, name)
+	if err != nil {
+		return nil, res, err
+	}
+	ac := &v1alpha1.ApplicationConfiguration{}
+	if err := h.Client.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: name}, ac); err != nil {
+		return nil, res, err
+	}
+	return ac, res, nil
+}
+
+// Recorder is an EventRecorder keeping events in memory.
+type Recorder struct {
+	l      sync.Mutex
+	events []string
+}
+
+var _ record.EventRecorder = &Recorder{}
+
+// Events returns the events recorded, as "<type> <reason> <message>".
+func (r *Recorder) Events() []string {
+	r.l.Lock()
+	defer r.l.Unlock()
+	return append([]string(nil), r.events...)
+}
+
+func (r *Recorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.l.Lock()
+	defer r.l.Unlock()
+	r.events = append(r.events, eventtype+" "+reason+" "+message)
+}
+
+func (r *Recorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *Recorder) PastEventf(object runtime.Object, timestamp metav1.Time, eventtype, reason, messageFmt string,
+	args ...interface{}) {
+	r.Eventf(object, eventtype, reason, messageFmt, args...)
+}
+
+func (r *Recorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason,
+	messageFmt string, args ...interface{}) {
+	r.Eventf(object, eventtype, reason, messageFmt, args...)
+}
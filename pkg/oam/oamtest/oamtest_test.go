@@ -0,0 +1,88 @@
+package oamtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// configHandler creates a ConfigMap for every component and sets the phase of applications.
+type configHandler struct {
+	err error
+}
+
+func (h *configHandler) Id() string {
+	return "config"
+}
+
+func (h *configHandler) Handle(ctx *oam.ActionContext, obj runtime.Object, eType oam.EType) error {
+	if h.err != nil {
+		return h.err
+	}
+	ac := obj.(*v1alpha1.ApplicationConfiguration)
+	ac.Status.Phase = v1alpha1.ApplicationProgressing
+	ctx.AddPost(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeUpdateStatus, Plan: ac})
+	return nil
+}
+
+func (h *configHandler) HandleComponent(ctx *oam.ActionContext, ac *v1alpha1.ApplicationConfiguration,
+	comp *v1alpha1.ComponentConfiguration, eType oam.EType) error {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ac.Namespace, Name: comp.InstanceName},
+		Data:       map[string]string{},
+	}
+	for _, v := range comp.ParameterValues {
+		cm.Data[v.Name] = v.Value
+	}
+	ctx.Add(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeCreate, Plan: cm})
+	return nil
+}
+
+func TestReconcile(t *testing.T) {
+	ac := &v1alpha1.ApplicationConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "shop"},
+		Spec: v1alpha1.ApplicationConfigurationSpec{Components: []v1alpha1.ComponentConfiguration{{
+			ComponentName:   "web",
+			InstanceName:    "shop-web",
+			ParameterValues: []v1alpha1.ParameterValue{{Name: "mode", Value: "prod"}},
+		}}},
+	}
+	h := New(nil, ac)
+	handler := &configHandler{}
+	h.Runtime.RegisterHandlers(oam.STypeApplicationConfiguration, handler)
+	h.Runtime.RegisterComponentHandlers(handler)
+
+	got, res, err := h.ReconcileApplication("default", "shop")
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.ApplicationProgressing, got.Status.Phase)
+	assert.Len(t, res.Actions, 2)
+	assert.Equal(t, oam.CmdTypeCreate, res.Actions[0].Command)
+	assert.Equal(t, oam.CmdTypeUpdateStatus, res.Actions[1].Command)
+	assert.Equal(t, []string{
+		"Normal Created created ConfigMap default/shop-web",
+		"Normal Updated updated status of ApplicationConfiguration default/shop",
+	}, res.Events)
+
+	cm := &corev1.ConfigMap{}
+	assert.NoError(t, h.Client.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "shop-web"}, cm))
+	assert.Equal(t, map[string]string{"mode": "prod"}, cm.Data)
+
+	handler.err = errors.New("boom")
+	res, err = h.Reconcile(oam.STypeApplicationConfiguration, "default", "shop")
+	assert.EqualError(t, err, "boom")
+	assert.Empty(t, res.Actions)
+	assert.Equal(t, []string{"Warning HandlerFailed handler config: boom"}, res.Events)
+
+	res, err = h.Reconcile(oam.STypeApplicationConfiguration, "default", "missing")
+	assert.NoError(t, err)
+	assert.Empty(t, res.Actions)
+	assert.Empty(t, res.Events)
+}
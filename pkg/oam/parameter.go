@@ -0,0 +1,86 @@
+package oam
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/apis/fieldpath"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PendingError means a parameter value comes from a component whose value is not available yet.
+type PendingError struct {
+	Component string
+	Parameter string
+	Reason    string
+}
+
+func (e *PendingError) Error() string {
+	return fmt.Sprintf("parameter %q is pending on component %q: %s", e.Parameter, e.Component, e.Reason)
+}
+
+// IsPending checks whether err is a PendingError.
+func IsPending(err error) bool {
+	_, ok := err.(*PendingError)
+	return ok
+}
+
+// ResolveParameters returns parameter values of comp, values taken From another component are read
+// from the objects produced for that component, which are found through ac.Status.Modules.
+// Produced objects should carry the v1alpha1.LabelComponent label to be recorded there.
+// A PendingError is returned if a referenced value is not available yet.
+func ResolveParameters(ctx context.Context, c client.Reader, ac *v1alpha1.ApplicationConfiguration,
+	comp *v1alpha1.ComponentConfiguration) ([]v1alpha1.ParameterValue, error) {
+	var values []v1alpha1.ParameterValue
+	for _, pv := range comp.ParameterValues {
+		if pv.From != nil {
+			v, err := resolveFrom(ctx, c, ac, pv)
+			if err != nil {
+				return nil, err
+			}
+			pv.Value = v
+		}
+		values = append(values, pv)
+	}
+	return values, nil
+}
+
+func resolveFrom(ctx context.Context, c client.Reader, ac *v1alpha1.ApplicationConfiguration,
+	pv v1alpha1.ParameterValue) (string, error) {
+	pending := &PendingError{Component: pv.From.Component, Parameter: pv.Name}
+	for _, m := range ac.Status.Modules {
+		if m.Component != pv.From.Component {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(m.GroupVersion)
+		obj.SetKind(m.Kind)
+		if err := c.Get(ctx, splitNamespacedName(m.NamespacedName), obj); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return "", err
+		}
+		v, found, err := fieldpath.Get(obj, pv.From.FieldPath)
+		if err != nil {
+			return "", err
+		}
+		if found {
+			return v, nil
+		}
+	}
+	pending.Reason = "field " + pv.From.FieldPath + " not available"
+	return "", pending
+}
+
+func splitNamespacedName(s string) types.NamespacedName {
+	parts := strings.SplitN(s, string(types.Separator), 2)
+	if len(parts) == 1 {
+		return types.NamespacedName{Name: parts[0]}
+	}
+	return types.NamespacedName{Namespace: parts[0], Name: parts[1]}
+}
@@ -0,0 +1,38 @@
+package oam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestResolveParameters(t *testing.T) {
+	svc := &corev1.Service{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db",
+			Namespace: "default",
+			Labels:    map[string]string{v1alpha1.LabelComponent: "db"},
+		},
+		Spec: corev1.ServiceSpec{ClusterIP: "10.0.0.1"},
+	}
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, svc)
+	ac := &v1alpha1.ApplicationConfiguration{}
+	comp := componentFrom("api", "db")
+	comp.ParameterValues = append(comp.ParameterValues, v1alpha1.ParameterValue{Name: "replicas", Value: "2"})
+
+	_, err := ResolveParameters(context.Background(), c, ac, &comp)
+	assert.True(t, IsPending(err))
+
+	ac.Status.Update([]metav1.Object{svc}, nil)
+	values, err := ResolveParameters(context.Background(), c, ac, &comp)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", values[0].Value)
+	assert.Equal(t, "2", values[1].Value)
+}
@@ -0,0 +1,40 @@
+package oam
+
+import (
+	"context"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PlanOptions configures Plan.
+type PlanOptions struct {
+	// AssumeReady handles components as if their dependencies were ready, they are only blocked by
+	// parameter values not available yet.
+	AssumeReady bool
+}
+
+// Plan invokes the handlers of spec type tp on obj, then component handlers for ApplicationConfigurations,
+// as for a CreateOrUpdate event and returns the actions they planned without doing them, with the
+// components blocked by their dependencies. Parameter values of other components are read with c.
+func (rt *Runtime) Plan(ctx context.Context, c client.Client, tp SType, obj runtime.Object,
+	opts PlanOptions) (*ActionContext, []v1alpha1.BlockedComponent, error) {
+	ctx, span := rt.getTracer().Start(ctx, "Plan "+string(tp), trace.WithAttributes(AttrSpecType.String(string(tp))))
+	defer span.End()
+	r := &Reconciler{
+		Client:      c,
+		specType:    tp,
+		Log:         oamLog.WithName("plan"),
+		Runtime:     rt,
+		assumeReady: opts.AssumeReady,
+	}
+	actionCtx := NewActionContext(obj, nil)
+	actionCtx.ctx = ctx
+	blocked, err := r.handle(ctx, actionCtx, obj, CreateOrUpdate, r.Log)
+	if err != nil {
+		return nil, nil, err
+	}
+	return actionCtx, blocked, nil
+}
@@ -0,0 +1,100 @@
+package oam
+
+import (
+	"context"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// Fields ApplicationConfigurations are indexed by in the manager cache, the names of ComponentSchematics,
+// Traits and ApplicationScopes they reference. List them with client.MatchingField.
+const (
+	IndexComponents = "spec.components.componentName"
+	IndexTraits     = "spec.components.traits.name"
+	IndexScopes     = "spec.components.applicationScopes"
+)
+
+// IndexWorkloadType is the field ComponentSchematics are indexed by in the manager cache, their workload type.
+const IndexWorkloadType = "spec.workloadType"
+
+// referenceIndexes maps index fields of ApplicationConfigurations to the functions extracting their values.
+var referenceIndexes = map[string]client.IndexerFunc{
+	IndexComponents: func(obj runtime.Object) []string {
+		if ac, ok := obj.(*v1alpha1.ApplicationConfiguration); ok {
+			return ac.Spec.ComponentNames()
+		}
+		return nil
+	},
+	IndexTraits: func(obj runtime.Object) []string {
+		if ac, ok := obj.(*v1alpha1.ApplicationConfiguration); ok {
+			return ac.Spec.TraitNames()
+		}
+		return nil
+	},
+	IndexScopes: func(obj runtime.Object) []string {
+		if ac, ok := obj.(*v1alpha1.ApplicationConfiguration); ok {
+			return ac.Spec.ScopeNames()
+		}
+		return nil
+	},
+}
+
+func indexWorkloadType(obj runtime.Object) []string {
+	if comp, ok := obj.(*v1alpha1.ComponentSchematic); ok && comp.Spec.WorkloadType != "" {
+		return []string{comp.Spec.WorkloadType}
+	}
+	return nil
+}
+
+// IndexReferences indexes ApplicationConfigurations by the objects they reference, see IndexComponents,
+// IndexTraits and IndexScopes. The ApplicationConfiguration reconciler registers them.
+func IndexReferences(indexer client.FieldIndexer) error {
+	for _, field := range []string{IndexComponents, IndexTraits, IndexScopes} {
+		if err := indexer.IndexField(&v1alpha1.ApplicationConfiguration{}, field, referenceIndexes[field]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexWorkloadTypes indexes ComponentSchematics by workload type, see IndexWorkloadType. The Component
+// reconciler registers it.
+func IndexWorkloadTypes(indexer client.FieldIndexer) error {
+	return indexer.IndexField(&v1alpha1.ComponentSchematic{}, IndexWorkloadType, indexWorkloadType)
+}
+
+// EnqueueReferencingApplications maps an object to the ApplicationConfigurations of its namespace referencing
+// it by name in the field index.
+func EnqueueReferencingApplications(c client.Reader, field string) handler.EventHandler {
+	return &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
+		list := &v1alpha1.ApplicationConfigurationList{}
+		if err := c.List(context.Background(), list, client.InNamespace(a.Meta.GetNamespace()),
+			client.MatchingField(field, a.Meta.GetName())); err != nil {
+			oamLog.Error(err, "list referencing ApplicationConfigurations error", "field", field, "name", a.Meta.GetName())
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, ac := range list.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: ac.Namespace, Name: ac.Name},
+			})
+		}
+		return requests
+	})}
+}
+
+// referenceWatches returns watches of ComponentSchematics, Traits and ApplicationScopes reconciling the
+// ApplicationConfigurations referencing them.
+func referenceWatches(c client.Reader) []watch {
+	return []watch{
+		{source: &source.Kind{Type: &v1alpha1.ComponentSchematic{}}, handler: EnqueueReferencingApplications(c, IndexComponents)},
+		{source: &source.Kind{Type: &v1alpha1.Trait{}}, handler: EnqueueReferencingApplications(c, IndexTraits)},
+		{source: &source.Kind{Type: &v1alpha1.ApplicationScope{}}, handler: EnqueueReferencingApplications(c, IndexScopes)},
+	}
+}
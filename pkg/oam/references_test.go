@@ -0,0 +1,92 @@
+package oam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// indexedReader lists ApplicationConfigurations matching field selectors with the reference indexes,
+// as the manager cache does.
+type indexedReader struct {
+	client.Reader
+	items []v1alpha1.ApplicationConfiguration
+}
+
+func (r *indexedReader) List(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+	o := &client.ListOptions{}
+	o.ApplyOptions(opts)
+	for _, ac := range r.items {
+		if ac.Namespace == o.Namespace && indexed(&ac, o.FieldSelector.Requirements()) {
+			l := list.(*v1alpha1.ApplicationConfigurationList)
+			l.Items = append(l.Items, ac)
+		}
+	}
+	return nil
+}
+
+func indexed(ac *v1alpha1.ApplicationConfiguration, requirements fields.Requirements) bool {
+	for _, req := range requirements {
+		found := false
+		for _, v := range referenceIndexes[req.Field](ac) {
+			found = found || v == req.Value
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func TestReferenceIndexes(t *testing.T) {
+	ac := &v1alpha1.ApplicationConfiguration{
+		Spec: v1alpha1.ApplicationConfigurationSpec{Components: []v1alpha1.ComponentConfiguration{
+			{ComponentName: "web", Traits: []v1alpha1.TraitBinding{{Name: "rollout"}, {Name: "ingress"}}, ApplicationScopes: []string{"net"}},
+			{ComponentName: "worker", Traits: []v1alpha1.TraitBinding{{Name: "rollout"}}},
+		}},
+	}
+	assert.Equal(t, []string{"web", "worker"}, referenceIndexes[IndexComponents](ac))
+	assert.Equal(t, []string{"rollout", "ingress"}, referenceIndexes[IndexTraits](ac))
+	assert.Equal(t, []string{"net"}, referenceIndexes[IndexScopes](ac))
+	assert.Empty(t, referenceIndexes[IndexComponents](&v1alpha1.Trait{}))
+}
+
+func TestEnqueueReferencingApplications(t *testing.T) {
+	app := func(ns, name, comp string) v1alpha1.ApplicationConfiguration {
+		return v1alpha1.ApplicationConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name},
+			Spec: v1alpha1.ApplicationConfigurationSpec{Components: []v1alpha1.ComponentConfiguration{
+				{ComponentName: comp},
+			}},
+		}
+	}
+	c := &indexedReader{items: []v1alpha1.ApplicationConfiguration{
+		app("default", "a", "web"), app("default", "b", "db"), app("other", "c", "web"),
+	}}
+	q := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	defer q.ShutDown()
+	comp := &v1alpha1.ComponentSchematic{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"}}
+	EnqueueReferencingApplications(c, IndexComponents).Update(event.UpdateEvent{
+		MetaOld: comp, ObjectOld: comp, MetaNew: comp, ObjectNew: comp,
+	}, q)
+
+	assert.Equal(t, 1, q.Len())
+	item, _ := q.Get()
+	assert.Equal(t, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "a"}}, item)
+}
+
+func TestIndexWorkloadType(t *testing.T) {
+	comp := &v1alpha1.ComponentSchematic{Spec: v1alpha1.ComponentSpec{WorkloadType: "core.oam.dev/v1alpha1.Server"}}
+	assert.Equal(t, []string{"core.oam.dev/v1alpha1.Server"}, indexWorkloadType(comp))
+	assert.Empty(t, indexWorkloadType(&v1alpha1.ComponentSchematic{}))
+}
@@ -1,97 +1,259 @@
 package oam
 
 import (
+	"errors"
 	"os"
 	"sync"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
-type Option func() error
+// Option registers a reconciler in a Runtime.
+type Option func(rt *Runtime) error
 
-type ControllerContext struct {
+// OptionFunc adapts f, an option written for the former Option type func() error, to an Option ignoring
+// the runtime it is given.
+func OptionFunc(f func() error) Option {
+	return func(*Runtime) error {
+		return f()
+	}
+}
+
+// Runtime runs OAM reconcilers with its own manager, handlers, owned and watched objects and options.
+// Runtimes are independent, several of them can run in a process.
+type Runtime struct {
 	mgr               ctrl.Manager
+	recorder          record.EventRecorder
+	tracerProvider    trace.TracerProvider
 	l                 *sync.RWMutex
 	handlers          map[SType][]Handler
+	componentHandlers []ComponentHandler
 	owns              map[SType][]runtime.Object
+	watches           map[SType][]watch
 	controllerOptions map[SType]controller.Options
+	shutdownHooks     []ShutdownHook
+	shutdownTimeout   time.Duration
+	inflight          inflight
+	phases            *phaseTracker
 }
 
+// ControllerContext is the former name of Runtime.
+//
+// Deprecated: use Runtime.
+type ControllerContext = Runtime
+
 var (
-	oamLog            = ctrl.Log.WithName("oam")
-	controllerContext = ControllerContext{
+	oamLog         = ctrl.Log.WithName("oam")
+	defaultRuntime = newRuntime()
+)
+
+// errNoManager is returned when running a Runtime without manager.
+var errNoManager = errors.New("oam runtime has no manager, call InitMgr first")
+
+func newRuntime() *Runtime {
+	return &Runtime{
 		handlers:          make(map[SType][]Handler),
 		owns:              make(map[SType][]runtime.Object),
+		watches:           make(map[SType][]watch),
 		l:                 new(sync.RWMutex),
 		controllerOptions: make(map[SType]controller.Options),
+		phases:            newPhaseTracker(),
 	}
-)
+}
 
-func InitMgr(conf *rest.Config, options ctrl.Options) {
-	if options.MetricsBindAddress == "" {
-		// disable metrics
-		options.MetricsBindAddress = "0"
+// New returns a Runtime with a new manager, configured by options then opts. Metrics are served on
+// options.MetricsBindAddress, ":8080" if not set, set it to "0" to disable them.
+// A nil conf returns a Runtime without manager, options and opts are ignored: its handlers plan actions
+// with Plan, Start and the options registering reconcilers return errNoManager.
+func New(conf *rest.Config, options ctrl.Options, opts ...ManagerOption) (*Runtime, error) {
+	if conf == nil {
+		return newRuntime(), nil
 	}
-	m, err := ctrl.NewManager(conf, options)
+	m, err := newManager(conf, options, opts...)
 	if err != nil {
-		oamLog.Error(err, "unable to init manager")
-		os.Exit(1)
+		return nil, err
 	}
-	controllerContext.mgr = m
+	return NewWithManager(m)
 }
 
-func GetMgr() ctrl.Manager {
-	return controllerContext.mgr
+// NewWithManager returns a Runtime running its reconcilers with m.
+func NewWithManager(m ctrl.Manager) (*Runtime, error) {
+	rt := newRuntime()
+	if err := rt.setMgr(m); err != nil {
+		return nil, err
+	}
+	return rt, nil
 }
 
-func RegisterHandlers(name SType, handlers ...Handler) {
-	controllerContext.l.Lock()
-	defer controllerContext.l.Unlock()
-	controllerContext.handlers[name] = append(controllerContext.handlers[name], handlers...)
+func newManager(conf *rest.Config, options ctrl.Options, opts ...ManagerOption) (ctrl.Manager, error) {
+	for _, o := range opts {
+		o(&options)
+	}
+	return ctrl.NewManager(conf, options)
 }
 
-func ControllerOption(name SType, opt controller.Options) {
-	controllerContext.l.Lock()
-	defer controllerContext.l.Unlock()
-	controllerContext.controllerOptions[name] = opt
+func (rt *Runtime) setMgr(m ctrl.Manager) error {
+	rt.l.Lock()
+	defer rt.l.Unlock()
+	rt.mgr = m
+	rt.recorder = NewRateLimitedRecorder(m.GetEventRecorderFor("oam-controller"), DefaultEventInterval)
+	return rt.addChecks()
 }
 
-func getControllerOption(name SType) controller.Options {
-	controllerContext.l.RLock()
-	defer controllerContext.l.RUnlock()
-	return controllerContext.controllerOptions[name]
+// GetMgr returns the manager of the runtime.
+func (rt *Runtime) GetMgr() ctrl.Manager {
+	rt.l.RLock()
+	defer rt.l.RUnlock()
+	return rt.mgr
 }
 
-func Owns(name SType, owns ...runtime.Object) {
-	controllerContext.l.Lock()
-	defer controllerContext.l.Unlock()
-	controllerContext.owns[name] = append(controllerContext.owns[name], owns...)
+func (rt *Runtime) getRecorder() record.EventRecorder {
+	rt.l.RLock()
+	defer rt.l.RUnlock()
+	return rt.recorder
+}
+
+// SetTracerProvider sets the OpenTelemetry TracerProvider of reconciles, handlers and actions, the
+// global one by default.
+func (rt *Runtime) SetTracerProvider(tp trace.TracerProvider) {
+	rt.l.Lock()
+	defer rt.l.Unlock()
+	rt.tracerProvider = tp
+}
+
+func (rt *Runtime) getTracer() trace.Tracer {
+	rt.l.RLock()
+	defer rt.l.RUnlock()
+	if rt.tracerProvider == nil {
+		return otel.Tracer(TracerName)
+	}
+	return rt.tracerProvider.Tracer(TracerName)
+}
+
+// RegisterHandlers registers handlers invoked for objects of the name spec type.
+func (rt *Runtime) RegisterHandlers(name SType, handlers ...Handler) {
+	rt.l.Lock()
+	defer rt.l.Unlock()
+	rt.handlers[name] = append(rt.handlers[name], handlers...)
+}
+
+// RegisterComponentHandlers registers handlers invoked for every component of ApplicationConfigurations.
+func (rt *Runtime) RegisterComponentHandlers(handlers ...ComponentHandler) {
+	rt.l.Lock()
+	defer rt.l.Unlock()
+	rt.componentHandlers = append(rt.componentHandlers, handlers...)
+}
+
+// ControllerOption sets the options of the name controller.
+func (rt *Runtime) ControllerOption(name SType, opt controller.Options) {
+	rt.l.Lock()
+	defer rt.l.Unlock()
+	rt.controllerOptions[name] = opt
+}
+
+func (rt *Runtime) getControllerOption(name SType) controller.Options {
+	rt.l.RLock()
+	defer rt.l.RUnlock()
+	return rt.controllerOptions[name]
+}
+
+// Owns registers objects owned by the name spec type, their changes reconcile their owner.
+func (rt *Runtime) Owns(name SType, owns ...runtime.Object) {
+	rt.l.Lock()
+	defer rt.l.Unlock()
+	rt.owns[name] = append(rt.owns[name], owns...)
+}
+
+func (rt *Runtime) getOwns(name SType) []runtime.Object {
+	rt.l.RLock()
+	defer rt.l.RUnlock()
+	return rt.owns[name]
+}
+
+type watch struct {
+	source  source.Source
+	handler handler.EventHandler
+}
+
+// Watches registers an extra watch of the name reconciler, events of src are mapped to requests by h.
+// Use it for objects the spec depends on without owning them, e.g. Secrets bound by traits.
+func (rt *Runtime) Watches(name SType, src source.Source, h handler.EventHandler) {
+	rt.l.Lock()
+	defer rt.l.Unlock()
+	rt.watches[name] = append(rt.watches[name], watch{source: src, handler: h})
+}
+
+func (rt *Runtime) getWatches(name SType) []watch {
+	rt.l.RLock()
+	defer rt.l.RUnlock()
+	return rt.watches[name]
+}
+
+func (rt *Runtime) getHandlers(name SType) []Handler {
+	rt.l.RLock()
+	defer rt.l.RUnlock()
+	return rt.handlers[name]
+}
+
+func (rt *Runtime) getComponentHandlers() []ComponentHandler {
+	rt.l.RLock()
+	defer rt.l.RUnlock()
+	return rt.componentHandlers
+}
+
+// Start registers reconcilers of options and runs the manager until stop is closed. Once stopped, it
+// waits for in-flight reconciles and calls shutdown hooks.
+func (rt *Runtime) Start(stop <-chan struct{}, options ...Option) error {
+	mgr := rt.GetMgr()
+	if mgr == nil {
+		return errNoManager
+	}
+	for _, o := range options {
+		if err := o(rt); err != nil {
+			return err
+		}
+	}
+
+	oamLog.Info("starting controller manager")
+	err := mgr.Start(stop)
+	if err != nil {
+		oamLog.Error(err, "problem running controller manager")
+	}
+	if serr := rt.shutdown(); err == nil {
+		err = serr
+	}
+	return err
 }
-func getOwns(name SType) []runtime.Object {
-	controllerContext.l.RLock()
-	defer controllerContext.l.RUnlock()
-	return controllerContext.owns[name]
+
+// Run is Start stopped on SIGTERM or SIGINT. It can only be called once in a process.
+func (rt *Runtime) Run(options ...Option) error {
+	return rt.Start(ctrl.SetupSignalHandler(), options...)
 }
 
-func getHandlers(name SType) []Handler {
-	controllerContext.l.RLock()
-	defer controllerContext.l.RUnlock()
-	return controllerContext.handlers[name]
+func (rt *Runtime) newReconciler(tp SType, mgr manager.Manager) *Reconciler {
+	r := NewReconciler(rt, tp, mgr.GetClient(), mgr.GetScheme())
+	r.Recorder = rt.getRecorder()
+	return r
 }
 
 func WithSpec(tp SType) Option {
-	return func() error {
-		return (&Reconciler{
-			specType:          tp,
-			Client:            controllerContext.mgr.GetClient(),
-			Log:               ctrl.Log.WithName("oma-controller").WithName(string(tp)),
-			Scheme:            controllerContext.mgr.GetScheme(),
-			ControllerContext: controllerContext,
-		}).SetupWithManager(controllerContext.mgr)
+	return func(rt *Runtime) error {
+		mgr := rt.GetMgr()
+		if mgr == nil {
+			return errNoManager
+		}
+		return rt.newReconciler(tp, mgr).SetupWithManager(mgr)
 	}
 
 }
@@ -121,17 +283,79 @@ func WithTrait() Option {
 func WithApplicationConfiguration() Option {
 	return WithSpec(STypeApplicationConfiguration)
 }
-func Run(options ...Option) error {
-	for _, o := range options {
-		if err := o(); err != nil {
-			return err
-		}
+
+// WithTracerProvider sets the OpenTelemetry TracerProvider of the runtime, see Runtime.SetTracerProvider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(rt *Runtime) error {
+		rt.SetTracerProvider(tp)
+		return nil
 	}
+}
 
-	oamLog.Info("starting controller manager")
-	if err := controllerContext.mgr.Start(ctrl.SetupSignalHandler()); err != nil {
-		oamLog.Error(err, "problem running controller manager")
-		return err
+// Default returns the runtime used by package level functions.
+func Default() *Runtime {
+	return defaultRuntime
+}
+
+// InitMgr creates the manager of the default runtime, configured by options then opts, exiting on error.
+// Metrics are served on options.MetricsBindAddress, ":8080" if not set, set it to "0" to disable them.
+func InitMgr(conf *rest.Config, options ctrl.Options, opts ...ManagerOption) {
+	m, err := newManager(conf, options, opts...)
+	if err == nil {
+		err = defaultRuntime.setMgr(m)
+	}
+	if err != nil {
+		oamLog.Error(err, "unable to init manager")
+		os.Exit(1)
 	}
-	return nil
+}
+
+// SetTracerProvider sets the TracerProvider of the default runtime, see Runtime.SetTracerProvider.
+func SetTracerProvider(tp trace.TracerProvider) {
+	defaultRuntime.SetTracerProvider(tp)
+}
+
+// GetMgr returns the manager of the default runtime.
+func GetMgr() ctrl.Manager {
+	return defaultRuntime.GetMgr()
+}
+
+// RegisterHandlers registers handlers in the default runtime, see Runtime.RegisterHandlers.
+func RegisterHandlers(name SType, handlers ...Handler) {
+	defaultRuntime.RegisterHandlers(name, handlers...)
+}
+
+// RegisterComponentHandlers registers handlers invoked for every component of ApplicationConfigurations.
+func RegisterComponentHandlers(handlers ...ComponentHandler) {
+	defaultRuntime.RegisterComponentHandlers(handlers...)
+}
+
+// ControllerOption sets controller options in the default runtime, see Runtime.ControllerOption.
+func ControllerOption(name SType, opt controller.Options) {
+	defaultRuntime.ControllerOption(name, opt)
+}
+
+// Owns registers owned objects in the default runtime, see Runtime.Owns.
+func Owns(name SType, owns ...runtime.Object) {
+	defaultRuntime.Owns(name, owns...)
+}
+
+// Watches registers an extra watch in the default runtime, see Runtime.Watches.
+func Watches(name SType, src source.Source, h handler.EventHandler) {
+	defaultRuntime.Watches(name, src, h)
+}
+
+// OnShutdown registers shutdown hooks in the default runtime, see Runtime.OnShutdown.
+func OnShutdown(hooks ...ShutdownHook) {
+	defaultRuntime.OnShutdown(hooks...)
+}
+
+// SetShutdownTimeout sets the shutdown timeout of the default runtime, see Runtime.SetShutdownTimeout.
+func SetShutdownTimeout(d time.Duration) {
+	defaultRuntime.SetShutdownTimeout(d)
+}
+
+// Run runs the default runtime, see Runtime.Run.
+func Run(options ...Option) error {
+	return defaultRuntime.Run(options...)
 }
@@ -0,0 +1,42 @@
+package oam
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type idHandler string
+
+func (h idHandler) Id() string { return string(h) }
+
+func (h idHandler) Handle(ctx *ActionContext, obj runtime.Object, eType EType) error { return nil }
+
+func TestRuntimes(t *testing.T) {
+	rt1, rt2 := newRuntime(), newRuntime()
+	rt1.RegisterHandlers(STypeComponent, idHandler("a"))
+	rt1.Owns(STypeComponent, &corev1.Service{})
+	rt2.RegisterHandlers(STypeComponent, idHandler("b"))
+
+	assert.Equal(t, []Handler{idHandler("a")}, rt1.getHandlers(STypeComponent))
+	assert.Equal(t, []Handler{idHandler("b")}, rt2.getHandlers(STypeComponent))
+	assert.Len(t, rt1.getOwns(STypeComponent), 1)
+	assert.Empty(t, rt2.getOwns(STypeComponent))
+	assert.Empty(t, Default().getHandlers(STypeComponent))
+
+	// a runtime without manager can't run
+	assert.Equal(t, errNoManager, rt1.Start(make(chan struct{}), WithComponent()))
+	assert.Equal(t, errNoManager, WithComponent()(rt1))
+	offline, err := New(nil, ctrl.Options{})
+	assert.NoError(t, err)
+	assert.Nil(t, offline.GetMgr())
+	assert.Equal(t, errNoManager, offline.Start(make(chan struct{})))
+
+	// options of the former type are adapted
+	called := false
+	assert.NoError(t, OptionFunc(func() error { called = true; return nil })(rt1))
+	assert.True(t, called)
+}
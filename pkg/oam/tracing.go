@@ -0,0 +1,43 @@
+package oam
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the OpenTelemetry tracer of the SDK.
+const TracerName = "github.com/oam-dev/oam-go-sdk/pkg/oam"
+
+// Attributes set on spans.
+const (
+	AttrSpecType  attribute.Key = "oam.spec_type"
+	AttrObject    attribute.Key = "oam.object"
+	AttrHandler   attribute.Key = "oam.handler"
+	AttrComponent attribute.Key = "oam.component"
+	AttrCommand   attribute.Key = "oam.action.command"
+	AttrPlan      attribute.Key = "oam.action.plan"
+	AttrPlanGVK   attribute.Key = "oam.action.plan_gvk"
+)
+
+// StartSpan starts a span nested in the span of ctx, with the TracerProvider of that span or the global
+// one if ctx has none. Handlers call it with ctx.Context() of their ActionContext so their spans nest in
+// the reconcile span, starting spans with their own OpenTelemetry tracer from this context works too.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	tp := otel.GetTracerProvider()
+	if parent := trace.SpanFromContext(ctx); parent.SpanContext().IsValid() {
+		tp = parent.TracerProvider()
+	}
+	return tp.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,69 @@
+package oam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestStartSpan(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "Reconcile")
+	// the TracerProvider of the parent span wins over the global one
+	_, child := StartSpan(ctx, "Handle h", AttrHandler.String("h"))
+	child.End()
+	parent.End()
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "Handle h", spans[0].Name)
+	assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, []attribute.KeyValue{AttrHandler.String("h")}, spans[0].Attributes)
+	assert.False(t, spans[1].Parent.IsValid())
+
+	// without parent span the global TracerProvider, a no-op one by default, is used
+	_, span := StartSpan(context.Background(), "noop")
+	assert.False(t, span.SpanContext().IsValid())
+}
+
+func TestDoActionsSpans(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	rt := newRuntime()
+	rt.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}}
+	r := NewReconciler(rt, STypeApplicationConfiguration, fake.NewFakeClientWithScheme(scheme.Scheme), scheme.Scheme)
+	ac := &v1alpha1.ApplicationConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	actionCtx := NewActionContext(ac, nil)
+	var span trace.Span
+	actionCtx.ctx, span = rt.getTracer().Start(context.Background(), "Reconcile")
+
+	actionCtx.Add(Action{Provider: PTypeK8S, Command: CmdTypeCreate, Plan: svc.DeepCopy()})
+	actionCtx.Add(Action{Provider: PTypeK8S, Command: CmdTypeCreate, Plan: svc.DeepCopy()})
+	assert.Error(t, r.doActions(actionCtx, ctrl.Log))
+	span.End()
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 3)
+	for _, s := range spans[:2] {
+		assert.Equal(t, "Action Create", s.Name)
+		assert.Equal(t, spans[2].SpanContext.SpanID(), s.Parent.SpanID())
+		assert.Contains(t, s.Attributes, AttrPlan.String("Service default/web"))
+		assert.Contains(t, s.Attributes, AttrPlanGVK.String("/v1, Kind=Service"))
+	}
+	assert.Equal(t, codes.Unset, spans[0].Status.Code)
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+	assert.Len(t, spans[1].Events, 1)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type Action struct {
@@ -36,8 +37,20 @@ const (
 	CmdTypeUpdate CmdType = "Update"
 	CmdTypeCreate CmdType = "Create"
 	CmdTypeDelete CmdType = "Delete"
+	// update status subresource of the plan
+	CmdTypeUpdateStatus CmdType = "UpdateStatus"
+	// patch the object of a PatchPlan
+	CmdTypePatch CmdType = "Patch"
+	// patch status subresource of the object of a PatchPlan
+	CmdTypePatchStatus CmdType = "PatchStatus"
 )
 
+// PatchPlan is the plan of patch commands, Patch is applied to Object.
+type PatchPlan struct {
+	Object runtime.Object
+	Patch  client.Patch
+}
+
 // Handler triggered by components, traits, scopes modify event, actions should be generate and add to ctx.
 // For actions need to be processed early, use ctx.AddPre; for actions need to be processed late, use ctx.AddPost.
 // For normal actions, just  use ctx.Add, OAM framework will do preActions -> actions -> postActions for you.
@@ -47,6 +60,15 @@ type Handler interface {
 	Handle(ctx *ActionContext, ac runtime.Object, EventType EType) error
 }
 
+// ComponentHandler triggered for every component of an ApplicationConfiguration, after Handlers of
+// STypeApplicationConfiguration. Components are handled in dependency order and comp comes with its
+// parameter values resolved, a component whose upstream values are not available yet is held pending
+// and retried later.
+type ComponentHandler interface {
+	Identity
+	HandleComponent(ctx *ActionContext, ac *v1alpha1.ApplicationConfiguration, comp *v1alpha1.ComponentConfiguration, EventType EType) error
+}
+
 type Identity interface {
 	Id() string
 }
@@ -0,0 +1,133 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+)
+
+// volatileMetadata are the metadata fields set by the API server, they are stripped from marshaled actions.
+var volatileMetadata = []string{"resourceVersion", "uid", "selfLink", "generation", "creationTimestamp"}
+
+// volatileFields are the fields holding times, they are stripped at any depth from marshaled actions.
+var volatileFields = map[string]bool{
+	"lastTransitionTime": true,
+	"lastUpdateTime":     true,
+	"lastProbeTime":      true,
+	"lastHeartbeatTime":  true,
+}
+
+// MarshalActions returns the plans of actions as YAML documents, each preceded by a comment with its
+// command and object. Documents are sorted by kind, namespace and name, actions on the same object are
+// kept in order. Fields set by the API server and times are stripped so the output is deterministic.
+func MarshalActions(scheme *runtime.Scheme, actions []oam.Action) ([]byte, error) {
+	type doc struct {
+		kind, namespace, name string
+		data                  []byte
+	}
+	docs := make([]doc, 0, len(actions))
+	for _, a := range actions {
+		plan, patch := a.Plan, ""
+		if pp, ok := plan.(*oam.PatchPlan); ok {
+			data, err := pp.Patch.Data(pp.Object)
+			if err != nil {
+				return nil, err
+			}
+			plan, patch = pp.Object, string(data)
+		}
+		obj, ok := plan.(runtime.Object)
+		if !ok {
+			data, err := yaml.Marshal(plan)
+			if err != nil {
+				return nil, err
+			}
+			docs = append(docs, doc{data: []byte(fmt.Sprintf("# %s %s\n%s", a.Provider, a.Command, data))})
+			continue
+		}
+		content, err := strip(scheme, obj)
+		if err != nil {
+			return nil, err
+		}
+		data, err := yaml.Marshal(content)
+		if err != nil {
+			return nil, err
+		}
+		d := doc{kind: fmt.Sprint(content["kind"])}
+		if m, err := meta.Accessor(obj); err == nil {
+			d.namespace, d.name = m.GetNamespace(), m.GetName()
+		}
+		header := fmt.Sprintf("# %s %s %s/%s\n", a.Command, d.kind, d.namespace, d.name)
+		if patch != "" {
+			header += "# patch: " + patch + "\n"
+		}
+		d.data = append([]byte(header), data...)
+		docs = append(docs, d)
+	}
+	sort.SliceStable(docs, func(i, j int) bool {
+		a, b := docs[i], docs[j]
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		if a.namespace != b.namespace {
+			return a.namespace < b.namespace
+		}
+		return a.name < b.name
+	})
+	buf := new(bytes.Buffer)
+	for _, d := range docs {
+		buf.WriteString("---\n")
+		buf.Write(d.data)
+	}
+	return buf.Bytes(), nil
+}
+
+// strip returns the content of obj, with its kind and without volatile fields.
+func strip(scheme *runtime.Scheme, obj runtime.Object) (map[string]interface{}, error) {
+	obj = obj.DeepCopyObject()
+	if obj.GetObjectKind().GroupVersionKind().Empty() {
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return nil, err
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+	var content map[string]interface{}
+	if u, ok := obj.(runtime.Unstructured); ok {
+		content = u.UnstructuredContent()
+	} else {
+		var err error
+		if content, err = runtime.DefaultUnstructuredConverter.ToUnstructured(obj); err != nil {
+			return nil, err
+		}
+	}
+	if m, ok := content["metadata"].(map[string]interface{}); ok {
+		for _, f := range volatileMetadata {
+			delete(m, f)
+		}
+	}
+	stripTimes(content)
+	return content, nil
+}
+
+func stripTimes(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, f := range v {
+			if volatileFields[k] {
+				delete(v, k)
+				continue
+			}
+			stripTimes(f)
+		}
+	case []interface{}:
+		for _, f := range v {
+			stripTimes(f)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package render
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Setup registers the handlers of rt, they read objects with c.
+type Setup func(rt *oam.Runtime, c client.Client) error
+
+// Command runs the render subcommand of a controller binary with args, the arguments following the
+// subcommand name:
+//
+//	render -f app.yaml -f components/ [-n namespace]
+//
+// Objects are loaded from the files and directories given with -f, the handlers registered by setup
+// render their ApplicationConfigurations and the objects planned are written to out.
+func Command(scheme *runtime.Scheme, args []string, out io.Writer, setup Setup) error {
+	fs := flag.NewFlagSet("render", flag.ContinueOnError)
+	var files fileList
+	fs.Var(&files, "f", "File or directory of objects to render, may be repeated.")
+	namespace := fs.String("n", DefaultNamespace, "Namespace of objects without namespace.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return errors.New("render: no file given, use -f")
+	}
+	objs, err := Load(scheme, files...)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		if m, err := meta.Accessor(obj); err == nil && m.GetNamespace() == "" {
+			m.SetNamespace(*namespace)
+		}
+	}
+	rt, err := oam.New(nil, ctrl.Options{})
+	if err != nil {
+		return err
+	}
+	r, err := New(rt, scheme, objs...)
+	if err != nil {
+		return err
+	}
+	if err := setup(rt, r.Client); err != nil {
+		return err
+	}
+	results, err := r.Render(context.Background())
+	if err != nil {
+		return err
+	}
+	return r.Write(out, results)
+}
+
+// fileList is a flag given several times.
+type fileList []string
+
+func (f *fileList) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *fileList) Set(v string) error {
+	*f = append(*f, v)
+	return nil
+}
@@ -0,0 +1,227 @@
+// Package render renders ApplicationConfigurations into the Kubernetes objects the handlers of a runtime
+// plan for them, without a cluster. Objects are loaded from files into an in-memory client handlers read
+// them with.
+package render
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// DefaultNamespace is the namespace of loaded objects without namespace.
+const DefaultNamespace = "default"
+
+// Document is a YAML or JSON document read from a file.
+type Document struct {
+	File string
+	// Line the document starts at in File, 1-based
+	Line int
+	Data []byte
+}
+
+// ReadDocuments reads the documents of YAML or JSON files, several documents may be separated by "---"
+// lines. Directories are walked, their files are read in lexical order and files without .yaml, .yml
+// or .json extension are skipped. Empty documents and documents only holding comments are skipped.
+func ReadDocuments(paths ...string) ([]Document, error) {
+	var docs []Document
+	for _, path := range paths {
+		files, err := expand(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			data, err := ioutil.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			lines := strings.Split(string(data), "\n")
+			start := 0
+			for i := 0; i <= len(lines); i++ {
+				if i < len(lines) && !isSeparator(lines[i]) {
+					continue
+				}
+				doc := strings.Join(lines[start:i], "\n")
+				if !isComment(doc) {
+					docs = append(docs, Document{File: file, Line: start + 1, Data: []byte(doc)})
+				}
+				start = i + 1
+			}
+		}
+	}
+	return docs, nil
+}
+
+// Load reads the objects of the documents of YAML or JSON files, see ReadDocuments. Objects are decoded
+// with the types of scheme.
+func Load(scheme *runtime.Scheme, paths ...string) ([]runtime.Object, error) {
+	docs, err := ReadDocuments(paths...)
+	if err != nil {
+		return nil, err
+	}
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	objs := make([]runtime.Object, 0, len(docs))
+	for _, doc := range docs {
+		obj, _, err := decoder.Decode(doc.Data, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", doc.File, doc.Line, err)
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// expand returns path, or the YAML and JSON files under directory path in lexical order.
+func expand(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	var files []string
+	err = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		switch filepath.Ext(p) {
+		case ".yaml", ".yml", ".json":
+			if !info.IsDir() {
+				files = append(files, p)
+			}
+		}
+		return nil
+	})
+	sort.Strings(files)
+	return files, err
+}
+
+func isSeparator(line string) bool {
+	line = strings.TrimRight(line, " \t\r")
+	return line == "---" || strings.HasPrefix(line, "--- ")
+}
+
+// isComment returns whether doc only holds comments, or nothing.
+func isComment(doc string) bool {
+	for _, line := range strings.Split(doc, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			return false
+		}
+	}
+	return true
+}
+
+// Renderer renders the ApplicationConfigurations of its objects with the handlers of Runtime.
+type Renderer struct {
+	Runtime *oam.Runtime
+	// Client serves the objects of the renderer, handlers read them with it.
+	Client client.Client
+	// Options of the plans of handlers, dependencies are assumed ready by default.
+	Options oam.PlanOptions
+
+	scheme *runtime.Scheme
+	apps   []*v1alpha1.ApplicationConfiguration
+}
+
+// New returns a Renderer of objs with the handlers of rt. Objects without namespace are put in
+// DefaultNamespace, their types must be in scheme.
+func New(rt *oam.Runtime, scheme *runtime.Scheme, objs ...runtime.Object) (*Renderer, error) {
+	r := &Renderer{Runtime: rt, Options: oam.PlanOptions{AssumeReady: true}, scheme: scheme}
+	copies := make([]runtime.Object, 0, len(objs))
+	for _, obj := range objs {
+		obj = obj.DeepCopyObject()
+		m, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		if m.GetNamespace() == "" {
+			m.SetNamespace(DefaultNamespace)
+		}
+		if ac, ok := obj.(*v1alpha1.ApplicationConfiguration); ok {
+			r.apps = append(r.apps, ac)
+		}
+		copies = append(copies, obj)
+	}
+	r.Client = fake.NewFakeClientWithScheme(scheme, copies...)
+	return r, nil
+}
+
+// Result is the rendering of an ApplicationConfiguration.
+type Result struct {
+	Application *v1alpha1.ApplicationConfiguration
+	// Actions planned by handlers, pre-actions first and post-actions last
+	Actions []oam.Action
+	// Components not rendered as values they read from other components are not available
+	Blocked []v1alpha1.BlockedComponent
+}
+
+// Objects returns the objects created or updated by the actions of the result, other actions are
+// only in Actions.
+func (r *Result) Objects() []runtime.Object {
+	var objs []runtime.Object
+	for _, a := range r.Actions {
+		if a.Provider != oam.PTypeK8S || (a.Command != oam.CmdTypeCreate && a.Command != oam.CmdTypeUpdate) {
+			continue
+		}
+		if obj, ok := a.Plan.(runtime.Object); ok {
+			objs = append(objs, obj)
+		}
+	}
+	return objs
+}
+
+// Render plans the actions of every ApplicationConfiguration, in the order they were given.
+func (r *Renderer) Render(ctx context.Context) ([]Result, error) {
+	results := make([]Result, 0, len(r.apps))
+	for _, app := range r.apps {
+		ac := app.DeepCopy()
+		actionCtx, blocked, err := r.Runtime.Plan(ctx, r.Client, oam.STypeApplicationConfiguration, ac, r.Options)
+		if err != nil {
+			return nil, fmt.Errorf("render %s/%s: %v", app.Namespace, app.Name, err)
+		}
+		var actions []oam.Action
+		actions = append(actions, actionCtx.PreActions...)
+		actions = append(actions, actionCtx.Actions...)
+		actions = append(actions, actionCtx.PostActions...)
+		results = append(results, Result{Application: ac, Actions: actions, Blocked: blocked})
+	}
+	return results, nil
+}
+
+// Write writes the actions of results as YAML documents, as written by MarshalActions, after a comment
+// naming their application. Blocked components are written as comments.
+func (r *Renderer) Write(w io.Writer, results []Result) error {
+	for _, res := range results {
+		if _, err := fmt.Fprintf(w, "# %s/%s\n", res.Application.Namespace, res.Application.Name); err != nil {
+			return err
+		}
+		for _, b := range res.Blocked {
+			if _, err := fmt.Fprintf(w, "# component %s not rendered: %s\n", b.ComponentName, b.Message); err != nil {
+				return err
+			}
+		}
+		data, err := MarshalActions(r.scheme, res.Actions)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package render
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// serverHandler renders components as Deployments of their first container.
+type serverHandler struct {
+	c client.Client
+}
+
+func (h *serverHandler) Id() string {
+	return "server"
+}
+
+func (h *serverHandler) HandleComponent(ctx *oam.ActionContext, ac *v1alpha1.ApplicationConfiguration,
+	comp *v1alpha1.ComponentConfiguration, eType oam.EType) error {
+	schematic := &v1alpha1.ComponentSchematic{}
+	if err := h.c.Get(ctx.Context(), types.NamespacedName{Namespace: ac.Namespace, Name: comp.ComponentName}, schematic); err != nil {
+		return err
+	}
+	var replicas int32 = 1
+	for _, v := range comp.ParameterValues {
+		if v.Name == "replicas" {
+			n, _ := strconv.Atoi(v.Value)
+			replicas = int32(n)
+		}
+	}
+	ctx.AddPost(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeDelete, Plan: &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: comp.InstanceName + "-old", Namespace: ac.Namespace},
+	}})
+	labels := map[string]string{"app": comp.InstanceName}
+	ctx.Add(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeCreate, Plan: &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: comp.InstanceName, Namespace: ac.Namespace, ResourceVersion: "1"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{
+					Name:  schematic.Spec.Containers[0].Name,
+					Image: schematic.Spec.Containers[0].Image,
+				}}},
+			},
+		},
+	}})
+	return nil
+}
+
+func testScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+	return scheme
+}
+
+func TestLoad(t *testing.T) {
+	objs, err := Load(testScheme(), "testdata")
+	assert.NoError(t, err)
+	assert.Len(t, objs, 4)
+	assert.IsType(t, &v1alpha1.ApplicationConfiguration{}, objs[0])
+	assert.IsType(t, &v1alpha1.ComponentSchematic{}, objs[1])
+	assert.IsType(t, &corev1.ConfigMap{}, objs[3])
+
+	_, err = Load(runtime.NewScheme(), "testdata/app.yaml")
+	assert.Error(t, err)
+	_, err = Load(testScheme(), "testdata/missing.yaml")
+	assert.Error(t, err)
+}
+
+func TestRender(t *testing.T) {
+	scheme := testScheme()
+	objs, err := Load(scheme, "testdata")
+	assert.NoError(t, err)
+	rt, err := oam.New(nil, ctrl.Options{})
+	assert.NoError(t, err)
+	r, err := New(rt, scheme, objs...)
+	assert.NoError(t, err)
+	rt.RegisterComponentHandlers(&serverHandler{c: r.Client})
+
+	results, err := r.Render(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	res := results[0]
+	assert.Equal(t, "default", res.Application.Namespace)
+	assert.Len(t, res.Objects(), 1)
+	d := res.Objects()[0].(*appsv1.Deployment)
+	assert.Equal(t, "shop-web", d.Name)
+	assert.Equal(t, int32(3), *d.Spec.Replicas)
+	assert.Equal(t, "nginx:latest", d.Spec.Template.Spec.Containers[0].Image)
+	assert.Len(t, res.Blocked, 1)
+	assert.Equal(t, "admin", res.Blocked[0].ComponentName)
+
+	var out bytes.Buffer
+	assert.NoError(t, r.Write(&out, results))
+	assert.Contains(t, out.String(), "# default/shop\n# component admin not rendered")
+	assert.Contains(t, out.String(), "---\n# Create Deployment default/shop-web\napiVersion: apps/v1\nkind: Deployment\n")
+	// actions other than create and update are written too
+	assert.Contains(t, out.String(), "---\n# Delete ConfigMap default/shop-web-old\n")
+	assert.NotContains(t, out.String(), "resourceVersion")
+}
+
+func TestCommand(t *testing.T) {
+	setup := func(rt *oam.Runtime, c client.Client) error {
+		rt.RegisterComponentHandlers(&serverHandler{c: c})
+		return nil
+	}
+	var out bytes.Buffer
+	assert.NoError(t, Command(testScheme(), []string{"-f", "testdata/app.yaml", "-f", "testdata/components.yaml", "-n", "shop"}, &out, setup))
+	assert.Contains(t, out.String(), "namespace: shop\n")
+	assert.Contains(t, out.String(), "name: shop-web\n")
+
+	assert.Error(t, Command(testScheme(), nil, &out, setup))
+}
@@ -0,0 +1,161 @@
+package revision
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// LabelComponentSchematic labels ControllerRevisions with the name of their ComponentSchematic.
+	LabelComponentSchematic = v1alpha1.Group + v1alpha1.Separator + "component-schematic"
+)
+
+// ComponentHandler snapshots every spec change of a ComponentSchematic into an immutable
+// ControllerRevision and records it in the ComponentSchematic status.
+// LatestCreatedComponentRevisionName is set once the revision is planned, LatestReadyComponentRevisionName
+// once it is stored.
+// Register it for oam.STypeComponent and run the component reconciler with oam.WithComponent.
+type ComponentHandler struct {
+	client.Reader
+}
+
+// NewComponentHandler returns a ComponentHandler reading revisions with c.
+func NewComponentHandler(c client.Reader) *ComponentHandler {
+	return &ComponentHandler{Reader: c}
+}
+
+func (h *ComponentHandler) Id() string {
+	return "ComponentRevisionHandler"
+}
+
+func (h *ComponentHandler) Handle(ctx *oam.ActionContext, obj runtime.Object, eType oam.EType) error {
+	comp, ok := obj.(*v1alpha1.ComponentSchematic)
+	if !ok {
+		return errors.New("type mismatch, ComponentSchematic expected")
+	}
+	if eType == oam.Delete {
+		// revisions are owned by the ComponentSchematic and garbage collected with it
+		return nil
+	}
+
+	rev, err := NewComponentRevision(comp)
+	if err != nil {
+		return err
+	}
+	existing := &appsv1.ControllerRevision{}
+	err = h.Get(ctx.Context(), types.NamespacedName{Namespace: rev.Namespace, Name: rev.Name}, existing)
+	if client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	found := err == nil
+	revisions, err := ListComponentRevisions(ctx.Context(), h, comp.Namespace, comp.Name)
+	if err != nil {
+		return err
+	}
+	var latest int64
+	if len(revisions) > 0 {
+		latest = revisions[len(revisions)-1].Revision
+	}
+	switch {
+	case !found:
+		rev.Revision = latest + 1
+		ctx.Add(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeCreate, Plan: rev})
+	case existing.Revision < latest:
+		// the spec of an older revision is back, it becomes the latest one
+		updated := existing.DeepCopy()
+		updated.Revision = latest + 1
+		ctx.Add(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeUpdate, Plan: updated})
+	}
+
+	status := comp.Status
+	status.LatestCreatedComponentRevisionName = rev.Name
+	if found {
+		status.LatestReadyComponentRevisionName = rev.Name
+	}
+	status.ObservedGeneration = comp.Generation
+	if status != comp.Status {
+		updated := comp.DeepCopy()
+		updated.Status = status
+		ctx.AddPost(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeUpdateStatus, Plan: updated})
+	}
+	return nil
+}
+
+// NewComponentRevision returns the ControllerRevision snapshotting the current spec of comp.
+// Its name is derived from the spec hash, so equal specs map to the same revision.
+func NewComponentRevision(comp *v1alpha1.ComponentSchematic) (*appsv1.ControllerRevision, error) {
+	data, err := json.Marshal(comp.Spec)
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      comp.Name + "-" + hash(data),
+			Namespace: comp.Namespace,
+			Labels:    map[string]string{LabelComponentSchematic: comp.Name},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(comp, v1alpha1.SchemeGroupVersion.WithKind("ComponentSchematic")),
+			},
+		},
+		Data:     runtime.RawExtension{Raw: data},
+		Revision: 1,
+	}, nil
+}
+
+// ListComponentRevisions returns revisions of the named ComponentSchematic, oldest first.
+func ListComponentRevisions(ctx context.Context, c client.Reader, namespace, name string) ([]appsv1.ControllerRevision, error) {
+	list := &appsv1.ControllerRevisionList{}
+	if err := c.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels{LabelComponentSchematic: name}); err != nil {
+		return nil, err
+	}
+	sortRevisions(list.Items)
+	return list.Items, nil
+}
+
+// GetComponent returns the ComponentSchematic used by comp: the spec stored in its pinned revision
+// if RevisionName is set, the current ComponentSchematic otherwise.
+func GetComponent(ctx context.Context, c client.Reader, namespace string,
+	comp *v1alpha1.ComponentConfiguration) (*v1alpha1.ComponentSchematic, error) {
+	if comp.RevisionName == "" {
+		cs := &v1alpha1.ComponentSchematic{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: comp.ComponentName}, cs); err != nil {
+			return nil, err
+		}
+		return cs, nil
+	}
+
+	rev := &appsv1.ControllerRevision{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: comp.RevisionName}, rev); err != nil {
+		return nil, err
+	}
+	if rev.Labels[LabelComponentSchematic] != comp.ComponentName {
+		return nil, fmt.Errorf("revision %s doesn't belong to component %s", comp.RevisionName, comp.ComponentName)
+	}
+	cs := &v1alpha1.ComponentSchematic{
+		ObjectMeta: metav1.ObjectMeta{Name: comp.ComponentName, Namespace: namespace},
+	}
+	if err := json.Unmarshal(rev.Data.Raw, &cs.Spec); err != nil {
+		return nil, err
+	}
+	cs.Status.LatestCreatedComponentRevisionName = rev.Name
+	cs.Status.LatestReadyComponentRevisionName = rev.Name
+	return cs, nil
+}
+
+func hash(data []byte) string {
+	hf := fnv.New32a()
+	_, _ = hf.Write(data)
+	return rand.SafeEncodeString(fmt.Sprint(hf.Sum32()))
+}
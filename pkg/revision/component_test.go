@@ -0,0 +1,101 @@
+package revision
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newScheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+	_ = scheme.AddToScheme(s)
+	_ = v1alpha1.AddToScheme(s)
+	return s
+}
+
+func TestComponentHandler(t *testing.T) {
+	comp := &v1alpha1.ComponentSchematic{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", Generation: 2},
+		Spec: v1alpha1.ComponentSpec{
+			WorkloadType: "core.oam.dev/v1alpha1.Server",
+			Containers:   []v1alpha1.Container{{Name: "web", Image: "nginx:1.16"}},
+		},
+	}
+	c := fake.NewFakeClientWithScheme(newScheme(), comp)
+	h := NewComponentHandler(c)
+
+	// first reconcile creates the revision
+	ctx := &oam.ActionContext{}
+	assert.NoError(t, h.Handle(ctx, comp, oam.CreateOrUpdate))
+	actions := ctx.Gather()
+	assert.Len(t, actions, 2)
+	assert.Equal(t, oam.CmdTypeCreate, actions[0].Command)
+	rev := actions[0].Plan.(*appsv1.ControllerRevision)
+	assert.Equal(t, int64(1), rev.Revision)
+	assert.Equal(t, oam.CmdTypeUpdateStatus, actions[1].Command)
+	status := actions[1].Plan.(*v1alpha1.ComponentSchematic).Status
+	assert.Equal(t, rev.Name, status.LatestCreatedComponentRevisionName)
+	assert.Empty(t, status.LatestReadyComponentRevisionName)
+	assert.Equal(t, int64(2), status.ObservedGeneration)
+
+	// once stored, the revision is ready
+	assert.NoError(t, c.Create(context.Background(), rev))
+	comp.Status = status
+	assert.NoError(t, h.Handle(ctx, comp, oam.CreateOrUpdate))
+	actions = ctx.Gather()
+	assert.Len(t, actions, 1)
+	comp.Status = actions[0].Plan.(*v1alpha1.ComponentSchematic).Status
+	assert.Equal(t, rev.Name, comp.Status.LatestReadyComponentRevisionName)
+
+	// nothing to do without spec change
+	assert.NoError(t, h.Handle(ctx, comp, oam.CreateOrUpdate))
+	assert.Empty(t, ctx.Gather())
+
+	// spec change creates the next revision
+	comp.Spec.Containers[0].Image = "nginx:1.17"
+	assert.NoError(t, h.Handle(ctx, comp, oam.CreateOrUpdate))
+	actions = ctx.Gather()
+	next := actions[0].Plan.(*appsv1.ControllerRevision)
+	assert.NotEqual(t, rev.Name, next.Name)
+	assert.Equal(t, int64(2), next.Revision)
+	assert.NoError(t, c.Create(context.Background(), next))
+
+	// pinned component uses the stored spec
+	pinned, err := GetComponent(context.Background(), c, "default",
+		&v1alpha1.ComponentConfiguration{ComponentName: "web", RevisionName: rev.Name})
+	assert.NoError(t, err)
+	assert.Equal(t, "nginx:1.16", pinned.Spec.Containers[0].Image)
+
+	latest, err := GetComponent(context.Background(), c, "default", &v1alpha1.ComponentConfiguration{ComponentName: "web"})
+	assert.NoError(t, err)
+	assert.Equal(t, "web", latest.Name)
+
+	revisions, err := ListComponentRevisions(context.Background(), c, "default", "web")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{rev.Name, next.Name}, []string{revisions[0].Name, revisions[1].Name})
+
+	// going back to the first spec makes its revision the latest one
+	comp.Spec.Containers[0].Image = "nginx:1.16"
+	assert.NoError(t, h.Handle(ctx, comp, oam.CreateOrUpdate))
+	actions = ctx.Gather()
+	assert.Equal(t, oam.CmdTypeUpdate, actions[0].Command)
+	reused := actions[0].Plan.(*appsv1.ControllerRevision)
+	assert.Equal(t, rev.Name, reused.Name)
+	assert.Equal(t, int64(3), reused.Revision)
+	assert.NoError(t, c.Update(context.Background(), reused))
+	revisions, err = ListComponentRevisions(context.Background(), c, "default", "web")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{next.Name, rev.Name}, []string{revisions[0].Name, revisions[1].Name})
+	assert.NoError(t, h.Handle(ctx, comp, oam.CreateOrUpdate))
+	for _, a := range ctx.Gather() {
+		assert.Equal(t, oam.CmdTypeUpdateStatus, a.Command)
+	}
+}
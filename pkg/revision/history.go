@@ -0,0 +1,244 @@
+package revision
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/pkg/client/clientset/versioned"
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// LabelApplicationHistory labels ControllerRevisions with the name of their ApplicationConfiguration.
+	LabelApplicationHistory = v1alpha1.Group + v1alpha1.Separator + "application-history"
+
+	// DefaultHistoryLimit is the number of ApplicationConfiguration revisions kept by default.
+	DefaultHistoryLimit = 10
+)
+
+// HistoryHandler stores applied ApplicationConfiguration specs as ControllerRevisions, with every
+// component pinned to the ComponentSchematic revision it resolved to, and records the current
+// revision number in the ApplicationConfiguration status.
+// Register it for oam.STypeApplicationConfiguration, a History rolls back to stored revisions.
+type HistoryHandler struct {
+	client.Reader
+	// Limit is the number of revisions kept, DefaultHistoryLimit if not set.
+	Limit int
+}
+
+// NewHistoryHandler returns a HistoryHandler reading revisions with c.
+func NewHistoryHandler(c client.Reader) *HistoryHandler {
+	return &HistoryHandler{Reader: c, Limit: DefaultHistoryLimit}
+}
+
+func (h *HistoryHandler) Id() string {
+	return "ApplicationHistoryHandler"
+}
+
+func (h *HistoryHandler) Handle(ctx *oam.ActionContext, obj runtime.Object, eType oam.EType) error {
+	ac, ok := obj.(*v1alpha1.ApplicationConfiguration)
+	if !ok {
+		return errors.New("type mismatch, ApplicationConfiguration expected")
+	}
+	if eType == oam.Delete {
+		// revisions are owned by the ApplicationConfiguration and garbage collected with it
+		return nil
+	}
+
+	spec, err := h.resolveSpec(ac)
+	if err != nil {
+		return err
+	}
+	rev, err := NewApplicationRevision(ac, spec)
+	if err != nil {
+		return err
+	}
+	revisions, err := ListApplicationRevisions(context.Background(), h, ac.Namespace, ac.Name)
+	if err != nil {
+		return err
+	}
+	var next int64 = 1
+	if len(revisions) > 0 {
+		next = revisions[len(revisions)-1].Revision + 1
+	}
+
+	var current *appsv1.ControllerRevision
+	var old []appsv1.ControllerRevision
+	for i := range revisions {
+		if revisions[i].Name == rev.Name {
+			current = revisions[i].DeepCopy()
+		} else {
+			old = append(old, revisions[i])
+		}
+	}
+	switch {
+	case current == nil:
+		rev.Revision = next
+		current = rev
+		ctx.Add(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeCreate, Plan: current})
+	case current.Revision != next-1:
+		// an older spec is applied again, it becomes the latest revision
+		current.Revision = next
+		ctx.Add(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeUpdate, Plan: current})
+	}
+
+	limit := h.Limit
+	if limit <= 0 {
+		limit = DefaultHistoryLimit
+	}
+	for i := 0; i < len(old)-(limit-1); i++ {
+		ctx.AddPost(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeDelete, Plan: old[i].DeepCopy()})
+	}
+
+	if ac.Status.CurrentRevision != current.Revision {
+		patch, err := json.Marshal(map[string]interface{}{
+			"status": map[string]interface{}{"currentRevision": current.Revision},
+		})
+		if err != nil {
+			return err
+		}
+		ctx.AddPost(oam.Action{
+			Provider: oam.PTypeK8S,
+			Command:  oam.CmdTypePatchStatus,
+			Plan:     &oam.PatchPlan{Object: ac.DeepCopy(), Patch: client.ConstantPatch(types.MergePatchType, patch)},
+		})
+	}
+	return nil
+}
+
+// resolveSpec returns the spec of ac with every component pinned to a ComponentSchematic revision.
+// Components without any revision are kept unpinned.
+func (h *HistoryHandler) resolveSpec(ac *v1alpha1.ApplicationConfiguration) (*v1alpha1.ApplicationConfigurationSpec, error) {
+	spec := ac.Spec.DeepCopy()
+	for i := range spec.Components {
+		comp := &spec.Components[i]
+		if comp.RevisionName != "" {
+			continue
+		}
+		cs := &v1alpha1.ComponentSchematic{}
+		err := h.Get(context.Background(), types.NamespacedName{Namespace: ac.Namespace, Name: comp.ComponentName}, cs)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		comp.RevisionName = cs.Status.LatestReadyComponentRevisionName
+	}
+	return spec, nil
+}
+
+// NewApplicationRevision returns the ControllerRevision storing spec as a revision of ac.
+// Its name is derived from the spec hash, so equal specs map to the same revision.
+func NewApplicationRevision(ac *v1alpha1.ApplicationConfiguration,
+	spec *v1alpha1.ApplicationConfigurationSpec) (*appsv1.ControllerRevision, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ac.Name + "-" + hash(data),
+			Namespace: ac.Namespace,
+			Labels:    map[string]string{LabelApplicationHistory: ac.Name},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(ac, v1alpha1.SchemeGroupVersion.WithKind("ApplicationConfiguration")),
+			},
+		},
+		Data:     runtime.RawExtension{Raw: data},
+		Revision: 1,
+	}, nil
+}
+
+// ListApplicationRevisions returns revisions of the named ApplicationConfiguration, oldest first.
+func ListApplicationRevisions(ctx context.Context, c client.Reader, namespace, name string) ([]appsv1.ControllerRevision, error) {
+	list := &appsv1.ControllerRevisionList{}
+	if err := c.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels{LabelApplicationHistory: name}); err != nil {
+		return nil, err
+	}
+	sortRevisions(list.Items)
+	return list.Items, nil
+}
+
+// History lists and rolls back ApplicationConfiguration revisions of a namespace through the generated clientset.
+type History struct {
+	oamclient versioned.Interface
+	k8sclient kubernetes.Interface
+	namespace string
+}
+
+// NewHistory returns the History of ApplicationConfigurations in namespace.
+func NewHistory(oamclient versioned.Interface, k8sclient kubernetes.Interface, namespace string) *History {
+	return &History{oamclient: oamclient, k8sclient: k8sclient, namespace: namespace}
+}
+
+// List returns revisions of the named ApplicationConfiguration, oldest first.
+func (h *History) List(name string) ([]appsv1.ControllerRevision, error) {
+	list, err := h.k8sclient.AppsV1().ControllerRevisions(h.namespace).List(metav1.ListOptions{
+		LabelSelector: LabelApplicationHistory + "=" + name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortRevisions(list.Items)
+	return list.Items, nil
+}
+
+// Rollback restores the spec stored in revision of the named ApplicationConfiguration, components
+// stay pinned to the ComponentSchematic revisions they used then.
+// Revision 0 means the revision before the current one.
+func (h *History) Rollback(ctx context.Context, name string, revision int64) (*v1alpha1.ApplicationConfiguration, error) {
+	ac, err := h.oamclient.CoreV1alpha1().ApplicationConfigurations(h.namespace).WithContext(ctx).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := h.List(name)
+	if err != nil {
+		return nil, err
+	}
+	target, err := findRevision(revisions, ac.Status.CurrentRevision, revision)
+	if err != nil {
+		return nil, err
+	}
+	spec := v1alpha1.ApplicationConfigurationSpec{}
+	if err := json.Unmarshal(target.Data.Raw, &spec); err != nil {
+		return nil, err
+	}
+	ac.Spec = spec
+	return h.oamclient.CoreV1alpha1().ApplicationConfigurations(h.namespace).WithContext(ctx).Update(ac)
+}
+
+func findRevision(revisions []appsv1.ControllerRevision, current, revision int64) (*appsv1.ControllerRevision, error) {
+	if revision == 0 {
+		// the latest revision before the current one
+		for i := len(revisions) - 1; i >= 0; i-- {
+			if revisions[i].Revision < current {
+				return &revisions[i], nil
+			}
+		}
+		return nil, fmt.Errorf("no revision before current revision %d", current)
+	}
+	for i := range revisions {
+		if revisions[i].Revision == revision {
+			return &revisions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("revision %d not found", revision)
+}
+
+func sortRevisions(revisions []appsv1.ControllerRevision) {
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+}
@@ -0,0 +1,106 @@
+package revision
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	oamfake "github.com/oam-dev/oam-go-sdk/pkg/client/clientset/versioned/fake"
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestHistoryHandler(t *testing.T) {
+	comp := &v1alpha1.ComponentSchematic{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Status:     v1alpha1.ComponentStatus{LatestReadyComponentRevisionName: "web-rev1"},
+	}
+	ac := &v1alpha1.ApplicationConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec: v1alpha1.ApplicationConfigurationSpec{
+			Components: []v1alpha1.ComponentConfiguration{{ComponentName: "web", InstanceName: "web"}},
+		},
+	}
+	c := fake.NewFakeClientWithScheme(newScheme(), comp, ac)
+	h := NewHistoryHandler(c)
+	h.Limit = 2
+
+	apply := func() []oam.Action {
+		ctx := &oam.ActionContext{}
+		assert.NoError(t, h.Handle(ctx, ac, oam.CreateOrUpdate))
+		actions := ctx.Gather()
+		for _, a := range actions {
+			switch a.Command {
+			case oam.CmdTypeCreate:
+				assert.NoError(t, c.Create(context.Background(), a.Plan.(*appsv1.ControllerRevision)))
+			case oam.CmdTypeUpdate:
+				assert.NoError(t, c.Update(context.Background(), a.Plan.(*appsv1.ControllerRevision)))
+			case oam.CmdTypeDelete:
+				assert.NoError(t, c.Delete(context.Background(), a.Plan.(*appsv1.ControllerRevision)))
+			case oam.CmdTypePatchStatus:
+				ac.Status.CurrentRevision++
+			}
+		}
+		return actions
+	}
+
+	actions := apply()
+	assert.Len(t, actions, 2)
+	rev1 := actions[0].Plan.(*appsv1.ControllerRevision)
+	assert.Contains(t, string(rev1.Data.Raw), `"revisionName":"web-rev1"`)
+	ac.Status.CurrentRevision = 1
+	assert.Empty(t, apply())
+
+	ac.Spec.Components[0].InstanceName = "web-v2"
+	apply()
+	ac.Status.CurrentRevision = 2
+	ac.Spec.Components[0].InstanceName = "web-v3"
+	actions = apply()
+	ac.Status.CurrentRevision = 3
+	// the oldest revision is pruned
+	assert.Equal(t, oam.CmdTypeDelete, actions[1].Command)
+	assert.Equal(t, rev1.Name, actions[1].Plan.(*appsv1.ControllerRevision).Name)
+
+	// applying the second spec again makes it the latest revision
+	ac.Spec.Components[0].InstanceName = "web-v2"
+	actions = apply()
+	assert.Equal(t, oam.CmdTypeUpdate, actions[0].Command)
+	assert.Equal(t, int64(4), actions[0].Plan.(*appsv1.ControllerRevision).Revision)
+	revisions, err := ListApplicationRevisions(context.Background(), c, "default", "app")
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 2)
+}
+
+func TestHistoryRollback(t *testing.T) {
+	ac := &v1alpha1.ApplicationConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec: v1alpha1.ApplicationConfigurationSpec{
+			Components: []v1alpha1.ComponentConfiguration{{ComponentName: "web", InstanceName: "web-v2"}},
+		},
+		Status: v1alpha1.ApplicationConfigurationStatus{CurrentRevision: 2},
+	}
+	spec1 := &v1alpha1.ApplicationConfigurationSpec{
+		Components: []v1alpha1.ComponentConfiguration{{ComponentName: "web", InstanceName: "web", RevisionName: "web-rev1"}},
+	}
+	rev1, err := NewApplicationRevision(ac, spec1)
+	assert.NoError(t, err)
+	rev2, err := NewApplicationRevision(ac, &ac.Spec)
+	assert.NoError(t, err)
+	rev2.Revision = 2
+
+	h := NewHistory(oamfake.NewSimpleClientset(ac), k8sfake.NewSimpleClientset(rev1, rev2), "default")
+	revisions, err := h.List("app")
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 2)
+
+	updated, err := h.Rollback(context.Background(), "app", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, *spec1, updated.Spec)
+
+	_, err = h.Rollback(context.Background(), "app", 3)
+	assert.Error(t, err)
+}
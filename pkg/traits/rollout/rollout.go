@@ -0,0 +1,525 @@
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/apis/flags"
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// TraitName is the name of the rollout trait.
+	TraitName = "rollout"
+
+	// StateAnnotation stores the rollout state on the Deployment.
+	StateAnnotation = "rollout." + v1alpha1.Group + v1alpha1.Separator + "state"
+	// TemplateHashAnnotation stores on the Deployment and its canary the hash of the pod template they
+	// were written with. Live templates are defaulted by the API server, so they are never hashed.
+	TemplateHashAnnotation = "rollout." + v1alpha1.Group + v1alpha1.Separator + "template-hash"
+
+	// LabelTrack is set to TrackCanary on the canary Deployment, its selector and its pods.
+	LabelTrack = "rollout." + v1alpha1.Group + v1alpha1.Separator + "track"
+	// TrackCanary is the LabelTrack value of canaries.
+	TrackCanary = "canary"
+	// CanarySuffix is appended to the name of a Deployment to name its canary.
+	CanarySuffix = "-canary"
+)
+
+// Parameter is the properties of the rollout trait.
+type Parameter struct {
+	// Replicas updated first, the rollout waits BatchInterval once they are ready.
+	CanaryReplicas int32 `json:"canaryReplicas,omitempty"`
+	// Number of batches the remaining replicas are updated in.
+	Batches int32 `json:"batches,omitempty"`
+	// Interval in second between batches.
+	BatchInterval int32 `json:"batchInterval,omitempty"`
+	// Interval in second between instances within a batch.
+	InstanceInterval int32 `json:"instanceInterval,omitempty"`
+	// Paused holds the rollout at its current step until set back to false.
+	Paused bool `json:"paused,omitempty"`
+	// Aborted restores the pod template the workload ran before the rollout.
+	Aborted bool `json:"aborted,omitempty"`
+}
+
+// Phase of a rollout.
+type Phase string
+
+const (
+	PhaseProgressing Phase = "Progressing"
+	PhaseWaiting     Phase = "Waiting"
+	PhasePaused      Phase = "Paused"
+	PhaseCompleted   Phase = "Completed"
+	PhaseAborted     Phase = "Aborted"
+)
+
+// State of a rollout, stored in StateAnnotation of the Deployment.
+type State struct {
+	// Hash of the pod template rolled out.
+	Revision string `json:"revision"`
+	// Pod template before the rollout, restored on abort.
+	StableTemplate corev1.PodTemplateSpec `json:"stableTemplate"`
+	// Hash of the pod template before the rollout, empty if the Deployment had none.
+	StableRevision string `json:"stableRevision,omitempty"`
+	// Index of the current step, the canary is the first step if any.
+	Step int32 `json:"step"`
+	// Canary replicas available at the last reconcile.
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+	// The rollout waits until this time before going on.
+	WaitUntil *metav1.Time `json:"waitUntil,omitempty"`
+	Phase     Phase        `json:"phase"`
+}
+
+// Progress of a rollout.
+type Progress struct {
+	Phase Phase
+	// Index of the current step and number of steps, the canary is the first step if any.
+	Step, Steps int32
+	// Updated replicas and replicas expected to be updated at the current step.
+	UpdatedReplicas, TargetReplicas int32
+	// Delay before the next step is due, 0 if the rollout isn't waiting.
+	RequeueAfter time.Duration
+}
+
+func (p Progress) String() string {
+	return fmt.Sprintf("step %d/%d, %d/%d replicas updated", p.Step+1, p.Steps, p.UpdatedReplicas, p.TargetReplicas)
+}
+
+// Engine progresses Deployment-backed workloads through a canary step and batches. The rolled out
+// template runs in a canary Deployment scaled to the replicas of the current step, while the Deployment
+// of the workload keeps the stable template for the other replicas, so a step never goes past its
+// replicas. Once all replicas are available in the canary, the workload Deployment is promoted to the
+// rolled out template and the canary is deleted when the workload is ready.
+type Engine struct {
+	// Now returns current time, time.Now if not set.
+	Now func() time.Time
+}
+
+// Plan is the Deployments to apply at a point of a rollout.
+type Plan struct {
+	// Stable is the Deployment of the workload.
+	Stable *appsv1.Deployment
+	// Canary runs the rolled out template during the rollout, nil if not needed.
+	Canary *appsv1.Deployment
+	// DeleteCanary is set when the canary given to Plan is no longer needed.
+	DeleteCanary bool
+}
+
+// Plan returns the Deployments to apply for desired and the progress of the rollout, given live the
+// Deployment of the workload and canary its canary Deployment, nil if they don't exist.
+func (e *Engine) Plan(param Parameter, live, canary, desired *appsv1.Deployment) (*Plan, Progress, error) {
+	revision := templateHash(&desired.Spec.Template)
+	plan := &Plan{Stable: desired.DeepCopy(), DeleteCanary: canary != nil}
+	setTemplateHash(plan.Stable, revision)
+	if live == nil {
+		// nothing to roll out from
+		return plan, Progress{Phase: PhaseCompleted}, nil
+	}
+	state, err := getState(live)
+	if err != nil {
+		return nil, Progress{}, err
+	}
+	liveRevision := live.Annotations[TemplateHashAnnotation]
+	if state == nil || state.Revision != revision {
+		if state == nil && liveRevision == revision {
+			return plan, Progress{Phase: PhaseCompleted}, nil
+		}
+		stable, stableRevision := live.Spec.Template, liveRevision
+		if state != nil && state.Phase != PhaseCompleted {
+			// a new spec came in the middle of a rollout, keep the template running before
+			stable, stableRevision = state.StableTemplate, state.StableRevision
+		}
+		state = &State{Revision: revision, StableTemplate: stable, StableRevision: stableRevision, Phase: PhaseProgressing}
+	}
+
+	replicas := int32(1)
+	if desired.Spec.Replicas != nil {
+		replicas = *desired.Spec.Replicas
+	}
+	targets := stepTargets(param, replicas)
+	if len(targets) == 0 {
+		// scaled to zero, there is nothing to roll out
+		state.Phase, state.Step = PhaseCompleted, 0
+	} else if state.Step >= int32(len(targets)) {
+		state.Step = int32(len(targets)) - 1
+	}
+	progress := Progress{Step: state.Step, Steps: int32(len(targets))}
+	if len(targets) > 0 {
+		progress.TargetReplicas = targets[state.Step]
+	}
+	scaled, available := canaryReplicas(canary, revision)
+	progress.UpdatedReplicas = available
+	now := e.now()
+
+	switch {
+	case state.Phase == PhaseCompleted:
+	case param.Aborted:
+		plan.Stable.Spec.Template = state.StableTemplate
+		setTemplateHash(plan.Stable, state.StableRevision)
+		state.Phase = PhaseAborted
+	case liveRevision == revision:
+		// promoted, the canary goes once the workload is ready
+		progress.UpdatedReplicas = replicas
+		if live.Generation == live.Status.ObservedGeneration && v1alpha1.ResourceStatus(live) == flags.StatusReady {
+			state.Phase = PhaseCompleted
+		} else {
+			state.Phase = PhaseProgressing
+			plan.Canary, plan.DeleteCanary = canaryDeployment(desired, revision, scaled), false
+		}
+	case param.Paused:
+		state.Phase = PhasePaused
+		scale(plan, state, desired, replicas, scaled, available)
+	case state.WaitUntil != nil && now.Before(state.WaitUntil.Time):
+		state.Phase = PhaseWaiting
+		progress.RequeueAfter = state.WaitUntil.Sub(now)
+		scale(plan, state, desired, replicas, scaled, available)
+	default:
+		state.WaitUntil = nil
+		state.Phase = PhaseProgressing
+		// the step is done once the canary is scaled to its replicas and ready
+		stepDone := scaled >= progress.TargetReplicas && v1alpha1.ResourceStatus(canary) == flags.StatusReady
+		if stepDone && state.Step == progress.Steps-1 {
+			// all replicas run the rolled out template, promote the workload
+			plan.Canary, plan.DeleteCanary = canaryDeployment(desired, revision, scaled), false
+			state.UpdatedReplicas = available
+			break
+		}
+		switch {
+		case stepDone:
+			state.Step++
+			progress.Step, progress.TargetReplicas = state.Step, targets[state.Step]
+			e.wait(state, &progress, param.BatchInterval, now)
+		case available > state.UpdatedReplicas && param.InstanceInterval > 0:
+			e.wait(state, &progress, param.InstanceInterval, now)
+		}
+		state.UpdatedReplicas = available
+		target := progress.TargetReplicas
+		switch {
+		case state.Phase == PhaseWaiting:
+			target = scaled
+		case param.InstanceInterval > 0 && available+1 < target:
+			// one instance at a time
+			target = available + 1
+		}
+		scale(plan, state, desired, replicas, target, available)
+	}
+	progress.Phase = state.Phase
+	if err := setState(plan.Stable, state); err != nil {
+		return nil, Progress{}, err
+	}
+	return plan, progress, nil
+}
+
+// scale runs canary replicas of the rolled out template in the canary and the stable template in the
+// workload. The workload only shrinks by the canary replicas available, so capacity is kept.
+func scale(plan *Plan, state *State, desired *appsv1.Deployment, replicas, canary, available int32) {
+	if available > canary {
+		available = canary
+	}
+	stable := replicas - available
+	plan.Stable.Spec.Template = state.StableTemplate
+	plan.Stable.Spec.Replicas = &stable
+	setTemplateHash(plan.Stable, state.StableRevision)
+	plan.Canary, plan.DeleteCanary = canaryDeployment(desired, state.Revision, canary), false
+}
+
+// canaryReplicas returns the replicas canary is scaled to and the replicas available, 0 if it doesn't run
+// the template of revision.
+func canaryReplicas(canary *appsv1.Deployment, revision string) (scaled, available int32) {
+	if canary == nil || canary.Annotations[TemplateHashAnnotation] != revision {
+		return 0, 0
+	}
+	if canary.Spec.Replicas != nil {
+		scaled = *canary.Spec.Replicas
+	}
+	if canary.Generation == canary.Status.ObservedGeneration {
+		available = canary.Status.AvailableReplicas
+	}
+	return scaled, available
+}
+
+// CanaryName returns the name of the canary Deployment of the Deployment name.
+func CanaryName(name string) string {
+	return name + CanarySuffix
+}
+
+// canaryTemplate returns the pod template of desired, labeled as canary.
+func canaryTemplate(desired *appsv1.Deployment) *corev1.PodTemplateSpec {
+	t := desired.Spec.Template.DeepCopy()
+	if t.Labels == nil {
+		t.Labels = map[string]string{}
+	}
+	t.Labels[LabelTrack] = TrackCanary
+	return t
+}
+
+// canaryDeployment returns the canary of desired, the template of revision, with replicas. Its selector and
+// pods have the LabelTrack label, so the workload doesn't count its pods while Services selecting the
+// workload pods select them.
+func canaryDeployment(desired *appsv1.Deployment, revision string, replicas int32) *appsv1.Deployment {
+	canary := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            CanaryName(desired.Name),
+			Namespace:       desired.Namespace,
+			Labels:          map[string]string{LabelTrack: TrackCanary},
+			Annotations:     map[string]string{TemplateHashAnnotation: revision},
+			OwnerReferences: desired.OwnerReferences,
+		},
+		Spec: *desired.Spec.DeepCopy(),
+	}
+	for k, v := range desired.Labels {
+		canary.Labels[k] = v
+	}
+	canary.Spec.Replicas = &replicas
+	canary.Spec.Paused = false
+	selector := &metav1.LabelSelector{}
+	if desired.Spec.Selector != nil {
+		selector = desired.Spec.Selector.DeepCopy()
+	}
+	if selector.MatchLabels == nil {
+		selector.MatchLabels = map[string]string{}
+	}
+	selector.MatchLabels[LabelTrack] = TrackCanary
+	canary.Spec.Selector = selector
+	canary.Spec.Template = *canaryTemplate(desired)
+	return canary
+}
+
+func (e *Engine) wait(state *State, progress *Progress, seconds int32, now time.Time) {
+	if seconds <= 0 {
+		return
+	}
+	d := time.Duration(seconds) * time.Second
+	until := metav1.NewTime(now.Add(d))
+	state.WaitUntil = &until
+	state.Phase = PhaseWaiting
+	progress.RequeueAfter = d
+}
+
+func (e *Engine) now() time.Time {
+	if e.Now != nil {
+		return e.Now()
+	}
+	return time.Now()
+}
+
+// stepTargets returns updated replicas expected at the end of every step.
+func stepTargets(param Parameter, replicas int32) []int32 {
+	var targets []int32
+	canary := param.CanaryReplicas
+	if canary > replicas {
+		canary = replicas
+	}
+	if canary > 0 {
+		targets = append(targets, canary)
+	}
+	batches := param.Batches
+	if batches <= 0 {
+		batches = 1
+	}
+	remaining := replicas - canary
+	if remaining <= 0 {
+		return targets
+	}
+	if batches > remaining {
+		batches = remaining
+	}
+	for i := int32(1); i <= batches; i++ {
+		// spread remaining replicas evenly, rounding up
+		targets = append(targets, canary+(remaining*i+batches-1)/batches)
+	}
+	return targets
+}
+
+// Status returns progress as recorded in the rollouts of ApplicationConfiguration status.
+func (p Progress) Status() v1alpha1.RolloutStatus {
+	status := v1alpha1.RolloutStatus{
+		Phase:           string(p.Phase),
+		Step:            p.Step,
+		Steps:           p.Steps,
+		UpdatedReplicas: p.UpdatedReplicas,
+		TargetReplicas:  p.TargetReplicas,
+		Message:         p.String(),
+	}
+	switch p.Phase {
+	case PhaseCompleted:
+		status.Message = "rollout completed"
+	case PhaseAborted:
+		status.Message = "rollout aborted, stable template restored"
+	}
+	return status
+}
+
+// Handle plans the rollout of desired, the Deployment a handler built for comp, and adds the actions
+// to ctx: the Deployment and its canary to create, update or delete, the rollout status of comp in ac and
+// the requeue of the next step. The rollout trait of comp is read from its properties, desired is created or
+// updated as is without it. Pausing, resuming and aborting is done by setting "paused" or "aborted" in
+// the trait properties. Register Watch so the rollout goes on when the Deployments change.
+func Handle(ctx *oam.ActionContext, c client.Reader, ac *v1alpha1.ApplicationConfiguration,
+	comp *v1alpha1.ComponentConfiguration, desired *appsv1.Deployment) error {
+	live, err := get(ctx.Context(), c, desired.Namespace, desired.Name)
+	if err != nil {
+		return err
+	}
+	setTemplateHash(desired, templateHash(&desired.Spec.Template))
+	if live == nil {
+		ctx.Add(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeCreate, Plan: desired})
+		return nil
+	}
+	param, ok, err := ParseParameter(comp)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		desired.ResourceVersion = live.ResourceVersion
+		ctx.Add(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeUpdate, Plan: desired})
+		return nil
+	}
+	canary, err := get(ctx.Context(), c, desired.Namespace, CanaryName(desired.Name))
+	if err != nil {
+		return err
+	}
+
+	plan, progress, err := (&Engine{}).Plan(*param, live, canary, desired)
+	if err != nil {
+		return err
+	}
+	plan.Stable.ResourceVersion = live.ResourceVersion
+	ctx.Add(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeUpdate, Plan: plan.Stable})
+	switch {
+	case plan.Canary != nil && canary == nil:
+		ctx.Add(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeCreate, Plan: plan.Canary})
+	case plan.Canary != nil:
+		plan.Canary.ResourceVersion = canary.ResourceVersion
+		ctx.Add(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeUpdate, Plan: plan.Canary})
+	case plan.DeleteCanary:
+		ctx.Add(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeDelete, Plan: canary})
+	}
+	ctx.RequeueAfter(progress.RequeueAfter)
+
+	status := progress.Status()
+	if current, ok := ac.Status.Rollouts[comp.ComponentName]; ok && current == status {
+		return nil
+	}
+	// a merge patch merges the entries of the rollouts map, other components and status fields are kept
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"rollouts": map[string]interface{}{comp.ComponentName: status},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	ctx.AddPost(oam.Action{
+		Provider: oam.PTypeK8S,
+		Command:  oam.CmdTypePatchStatus,
+		Plan:     &oam.PatchPlan{Object: ac.DeepCopy(), Patch: client.ConstantPatch(types.MergePatchType, patch)},
+	})
+	return nil
+}
+
+// get returns the Deployment namespace/name, nil if it doesn't exist.
+func get(ctx context.Context, c client.Reader, namespace, name string) (*appsv1.Deployment, error) {
+	d := &appsv1.Deployment{}
+	err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, d)
+	if client.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, nil
+	}
+	return d, nil
+}
+
+// Watch registers a watch of Deployments for the ApplicationConfiguration reconciler of rt, so rollouts
+// go on as their Deployments become available. Deployments are mapped to the ApplicationConfiguration
+// in their v1alpha1.LabelApplicationConfiguration label, handlers set it on the Deployments they build.
+func Watch(rt *oam.Runtime) {
+	rt.Watches(oam.STypeApplicationConfiguration, &source.Kind{Type: &appsv1.Deployment{}}, EnqueueApplication())
+}
+
+// EnqueueApplication maps an object to the ApplicationConfiguration in its
+// v1alpha1.LabelApplicationConfiguration label.
+func EnqueueApplication() handler.EventHandler {
+	return &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
+		name, ok := a.Meta.GetLabels()[v1alpha1.LabelApplicationConfiguration]
+		if !ok || name == "" {
+			return nil
+		}
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: a.Meta.GetNamespace(), Name: name}}}
+	})}
+}
+
+// ParseParameter reads the rollout trait properties of comp, ok is false if comp has no rollout trait.
+func ParseParameter(comp *v1alpha1.ComponentConfiguration) (param *Parameter, ok bool, err error) {
+	for _, t := range comp.Traits {
+		if t.Name != TraitName {
+			continue
+		}
+		param = &Parameter{}
+		if len(t.Properties.Raw) > 0 {
+			if err := json.Unmarshal(t.Properties.Raw, param); err != nil {
+				return nil, false, fmt.Errorf("invalid %s trait properties: %v", TraitName, err)
+			}
+		}
+		return param, true, nil
+	}
+	return nil, false, nil
+}
+
+func getState(d *appsv1.Deployment) (*State, error) {
+	data, ok := d.Annotations[StateAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	state := &State{}
+	if err := json.Unmarshal([]byte(data), state); err != nil {
+		return nil, fmt.Errorf("invalid rollout state of deployment %s: %v", d.Name, err)
+	}
+	return state, nil
+}
+
+func setState(d *appsv1.Deployment, state *State) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if d.Annotations == nil {
+		d.Annotations = map[string]string{}
+	}
+	d.Annotations[StateAnnotation] = string(data)
+	return nil
+}
+
+// setTemplateHash records hash in the TemplateHashAnnotation of d, removing it if hash is empty.
+func setTemplateHash(d *appsv1.Deployment, hash string) {
+	if hash == "" {
+		delete(d.Annotations, TemplateHashAnnotation)
+		return
+	}
+	if d.Annotations == nil {
+		d.Annotations = map[string]string{}
+	}
+	d.Annotations[TemplateHashAnnotation] = hash
+}
+
+// templateHash returns the hash of t, a template built by a handler and not defaulted by the API server.
+func templateHash(t *corev1.PodTemplateSpec) string {
+	data, _ := json.Marshal(t)
+	hf := fnv.New32a()
+	_, _ = hf.Write(data)
+	return rand.SafeEncodeString(fmt.Sprint(hf.Sum32()))
+}
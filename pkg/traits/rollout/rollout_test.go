@@ -0,0 +1,286 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func deployment(image string, replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: image}}},
+			},
+		},
+	}
+}
+
+// observe returns out as seen by the deployment controller with available replicas ready, its pod
+// template defaulted by the API server.
+func observe(out *appsv1.Deployment, available int32) *appsv1.Deployment {
+	live := out.DeepCopy()
+	setDefaults(&live.Spec.Template.Spec)
+	live.Status = appsv1.DeploymentStatus{
+		Replicas: available, UpdatedReplicas: available, ReadyReplicas: available, AvailableReplicas: available,
+	}
+	return live
+}
+
+// setDefaults sets some of the fields the API server defaults in pod specs.
+func setDefaults(spec *corev1.PodSpec) {
+	spec.RestartPolicy = corev1.RestartPolicyAlways
+	spec.DNSPolicy = corev1.DNSClusterFirst
+	spec.SchedulerName = corev1.DefaultSchedulerName
+	spec.SecurityContext = &corev1.PodSecurityContext{}
+	for i := range spec.Containers {
+		c := &spec.Containers[i]
+		c.ImagePullPolicy = corev1.PullIfNotPresent
+		c.TerminationMessagePath = corev1.TerminationMessagePathDefault
+		c.TerminationMessagePolicy = corev1.TerminationMessageReadFile
+	}
+}
+
+func TestStepTargets(t *testing.T) {
+	assert.Equal(t, []int32{1, 2, 3, 4}, stepTargets(Parameter{CanaryReplicas: 1, Batches: 3}, 4))
+	assert.Equal(t, []int32{2, 6, 10}, stepTargets(Parameter{CanaryReplicas: 2, Batches: 2}, 10))
+	assert.Equal(t, []int32{3}, stepTargets(Parameter{}, 3))
+	assert.Equal(t, []int32{2}, stepTargets(Parameter{CanaryReplicas: 5}, 2))
+	assert.Equal(t, []int32{1, 2}, stepTargets(Parameter{Batches: 5}, 2))
+	assert.Empty(t, stepTargets(Parameter{CanaryReplicas: 1}, 0))
+}
+
+func TestPlan(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := &Engine{Now: func() time.Time { return now }}
+	param := Parameter{CanaryReplicas: 1, Batches: 3, BatchInterval: 30}
+	stable := deployment("nginx:1.16", 4)
+	desired := deployment("nginx:1.17", 4)
+
+	// nothing to roll out without a running deployment or template change
+	plan, progress, err := e.Plan(param, nil, nil, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseCompleted, progress.Phase)
+	assert.Equal(t, desired.Spec, plan.Stable.Spec)
+	assert.Nil(t, plan.Canary)
+	assert.NotEmpty(t, plan.Stable.Annotations[TemplateHashAnnotation])
+	_, progress, err = e.Plan(param, observe(plan.Stable, 4), nil, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseCompleted, progress.Phase)
+
+	// canary step starts, the workload keeps its replicas until canary replicas are available
+	old, _, err := e.Plan(param, nil, nil, stable)
+	assert.NoError(t, err)
+	plan, progress, err = e.Plan(param, observe(old.Stable, 4), nil, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, Progress{Phase: PhaseProgressing, Step: 0, Steps: 4, TargetReplicas: 1}, progress)
+	assert.Equal(t, "nginx:1.16", plan.Stable.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, int32(4), *plan.Stable.Spec.Replicas)
+	assert.Equal(t, "web-canary", plan.Canary.Name)
+	assert.Equal(t, int32(1), *plan.Canary.Spec.Replicas)
+	assert.Equal(t, "nginx:1.17", plan.Canary.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, map[string]string{"app": "web", LabelTrack: TrackCanary}, plan.Canary.Spec.Selector.MatchLabels)
+	assert.Equal(t, map[string]string{"app": "web", LabelTrack: TrackCanary}, plan.Canary.Spec.Template.Labels)
+
+	// canary is available, rollout waits before the first batch holding the canary replicas
+	plan, progress, err = e.Plan(param, observe(plan.Stable, 4), observe(plan.Canary, 1), desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseWaiting, progress.Phase)
+	assert.Equal(t, int32(1), progress.Step)
+	assert.Equal(t, 30*time.Second, progress.RequeueAfter)
+	assert.Equal(t, int32(1), *plan.Canary.Spec.Replicas)
+	assert.Equal(t, int32(3), *plan.Stable.Spec.Replicas)
+
+	now = now.Add(10 * time.Second)
+	plan, progress, err = e.Plan(param, observe(plan.Stable, 3), observe(plan.Canary, 1), desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseWaiting, progress.Phase)
+	assert.Equal(t, 20*time.Second, progress.RequeueAfter)
+	assert.Equal(t, int32(1), *plan.Canary.Spec.Replicas)
+
+	// pause holds the rollout after the interval
+	now = now.Add(30 * time.Second)
+	param.Paused = true
+	plan, progress, err = e.Plan(param, observe(plan.Stable, 3), observe(plan.Canary, 1), desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhasePaused, progress.Phase)
+	assert.Equal(t, int32(1), *plan.Canary.Spec.Replicas)
+
+	// resume goes on with the batch, scaled to its replicas only
+	param.Paused = false
+	plan, progress, err = e.Plan(param, observe(plan.Stable, 3), observe(plan.Canary, 1), desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseProgressing, progress.Phase)
+	assert.Equal(t, int32(2), progress.TargetReplicas)
+	assert.Equal(t, int32(2), *plan.Canary.Spec.Replicas)
+	assert.Equal(t, int32(3), *plan.Stable.Spec.Replicas)
+
+	// abort restores the stable template and deletes the canary
+	param.Aborted = true
+	plan, progress, err = e.Plan(param, observe(plan.Stable, 3), observe(plan.Canary, 1), desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseAborted, progress.Phase)
+	assert.Equal(t, observe(stable, 4).Spec.Template, plan.Stable.Spec.Template)
+	assert.Equal(t, old.Stable.Annotations[TemplateHashAnnotation], plan.Stable.Annotations[TemplateHashAnnotation])
+	assert.Equal(t, int32(4), *plan.Stable.Spec.Replicas)
+	assert.Nil(t, plan.Canary)
+	assert.True(t, plan.DeleteCanary)
+}
+
+func TestPlanInstanceInterval(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := &Engine{Now: func() time.Time { return now }}
+	param := Parameter{InstanceInterval: 10}
+	desired := deployment("nginx:1.17", 3)
+
+	plan, _, err := e.Plan(param, observe(deployment("nginx:1.16", 3), 3), nil, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), *plan.Canary.Spec.Replicas)
+
+	// the instance is available, the next one waits for the interval
+	plan, progress, err := e.Plan(param, observe(plan.Stable, 3), observe(plan.Canary, 1), desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseWaiting, progress.Phase)
+	assert.Equal(t, int32(1), *plan.Canary.Spec.Replicas)
+
+	now = now.Add(10 * time.Second)
+	plan, progress, err = e.Plan(param, observe(plan.Stable, 2), observe(plan.Canary, 1), desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseProgressing, progress.Phase)
+	assert.Equal(t, int32(2), *plan.Canary.Spec.Replicas)
+}
+
+func TestPlanCompleted(t *testing.T) {
+	e := &Engine{}
+	desired := deployment("nginx:1.17", 2)
+	plan, _, err := e.Plan(Parameter{}, observe(deployment("nginx:1.16", 2), 2), nil, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), *plan.Canary.Spec.Replicas)
+
+	// all replicas are available in the canary, the workload is promoted
+	canary := observe(plan.Canary, 2)
+	plan, progress, err := e.Plan(Parameter{}, observe(plan.Stable, 2), canary, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseProgressing, progress.Phase)
+	assert.Equal(t, desired.Spec.Template, plan.Stable.Spec.Template)
+	assert.Equal(t, int32(2), *plan.Stable.Spec.Replicas)
+	assert.Equal(t, int32(2), *plan.Canary.Spec.Replicas)
+
+	// the canary is kept until the workload is ready
+	plan, progress, err = e.Plan(Parameter{}, observe(plan.Stable, 0), canary, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseProgressing, progress.Phase)
+	assert.NotNil(t, plan.Canary)
+
+	plan, progress, err = e.Plan(Parameter{}, observe(plan.Stable, 2), canary, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseCompleted, progress.Phase)
+	assert.Nil(t, plan.Canary)
+	assert.True(t, plan.DeleteCanary)
+
+	assert.Equal(t, v1alpha1.RolloutStatus{Phase: "Completed", Steps: 1, UpdatedReplicas: 2, TargetReplicas: 2,
+		Message: "rollout completed"}, progress.Status())
+
+	// a completed rollout stays completed
+	plan, progress, err = e.Plan(Parameter{}, observe(plan.Stable, 2), nil, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseCompleted, progress.Phase)
+	assert.False(t, plan.DeleteCanary)
+}
+
+func TestPlanWaitsForReadyCanary(t *testing.T) {
+	e := &Engine{}
+	desired := deployment("nginx:1.17", 2)
+	plan, _, err := e.Plan(Parameter{CanaryReplicas: 1}, observe(deployment("nginx:1.16", 2), 2), nil, desired)
+	assert.NoError(t, err)
+	live := observe(plan.Stable, 2)
+
+	// the canary spec is not observed yet
+	canary := observe(plan.Canary, 1)
+	canary.Generation, canary.Status.ObservedGeneration = 2, 1
+	_, progress, err := e.Plan(Parameter{CanaryReplicas: 1}, live, canary, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), progress.Step)
+
+	// an available replica is not updated yet
+	canary = observe(plan.Canary, 1)
+	canary.Status.UpdatedReplicas = 0
+	_, progress, err = e.Plan(Parameter{CanaryReplicas: 1}, live, canary, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), progress.Step)
+
+	_, progress, err = e.Plan(Parameter{CanaryReplicas: 1}, live, observe(plan.Canary, 1), desired)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), progress.Step)
+}
+
+func TestPlanDefaultedTemplates(t *testing.T) {
+	e := &Engine{}
+	desired := deployment("nginx:1.17", 2)
+
+	// adding the trait to a running workload doesn't start a rollout
+	created, _, err := e.Plan(Parameter{}, nil, nil, desired)
+	assert.NoError(t, err)
+	plan, progress, err := e.Plan(Parameter{}, observe(created.Stable, 2), nil, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseCompleted, progress.Phase)
+	assert.Nil(t, plan.Canary)
+
+	// defaulted canary and promoted templates are recognized by their annotation
+	desired = deployment("nginx:1.18", 2)
+	plan, _, err = e.Plan(Parameter{}, observe(plan.Stable, 2), nil, desired)
+	assert.NoError(t, err)
+	canary := observe(plan.Canary, 2)
+	plan, progress, err = e.Plan(Parameter{}, observe(plan.Stable, 2), canary, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), progress.UpdatedReplicas)
+	assert.Equal(t, desired.Spec.Template, plan.Stable.Spec.Template)
+	plan, progress, err = e.Plan(Parameter{}, observe(plan.Stable, 2), canary, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseCompleted, progress.Phase)
+	assert.Equal(t, desired.Spec.Template, plan.Stable.Spec.Template)
+	assert.True(t, plan.DeleteCanary)
+}
+
+func TestHandleStatus(t *testing.T) {
+	live := observe(deployment("nginx:1.16", 2), 2)
+	c := fake.NewFakeClientWithScheme(scheme.Scheme, live)
+	comp := &v1alpha1.ComponentConfiguration{ComponentName: "web",
+		Traits: []v1alpha1.TraitBinding{{Name: TraitName}}}
+	ac := &v1alpha1.ApplicationConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+	ac.Status.Rollouts = map[string]v1alpha1.RolloutStatus{"db": {Phase: "Paused"}}
+	ac.Status.SetConditionTrue(v1alpha1.Ready, "Ready", "")
+
+	ctx := oam.NewActionContext(ac, nil)
+	assert.NoError(t, Handle(ctx, c, ac, comp, deployment("nginx:1.17", 2)))
+	actions := ctx.Gather()
+	last := actions[len(actions)-1]
+	assert.Equal(t, oam.CmdTypePatchStatus, last.Command)
+	pp := last.Plan.(*oam.PatchPlan)
+	data, err := pp.Patch.Data(pp.Object)
+	assert.NoError(t, err)
+	// only the rollout of the component is patched
+	assert.JSONEq(t, `{"status":{"rollouts":{"web":{"phase":"Progressing","steps":1,"targetReplicas":2,
+		"message":"step 1/1, 0/2 replicas updated"}}}}`, string(data))
+}
+
+func TestPlanScaledToZero(t *testing.T) {
+	desired := deployment("nginx:1.17", 0)
+	plan, progress, err := (&Engine{}).Plan(Parameter{CanaryReplicas: 1}, observe(deployment("nginx:1.16", 0), 0), nil, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, PhaseCompleted, progress.Phase)
+	assert.Equal(t, desired.Spec.Template, plan.Stable.Spec.Template)
+	assert.Nil(t, plan.Canary)
+}
@@ -0,0 +1,353 @@
+package servicebinding
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// TraitName is the name of the service binding trait.
+	TraitName = "servicebinding"
+
+	// ChecksumAnnotation records the checksum of bound values on the pod template, pods are
+	// rolled when a bound object changes.
+	ChecksumAnnotation = "servicebinding." + v1alpha1.Group + v1alpha1.Separator + "checksum"
+
+	// IndexBindings is the field ApplicationConfigurations are indexed by in the manager cache, the
+	// <kind>/<name> of the Secrets and ConfigMaps they bind. List them with client.MatchingField.
+	IndexBindings = "spec.components.traits.servicebindings.source.objectRef"
+)
+
+// Parameter is the properties of the service binding trait.
+type Parameter struct {
+	ServiceBindings []Binding `json:"servicebindings,omitempty"`
+}
+
+// Binding projects the values of Source into the workload: as env vars prefixed with EnvPrefix,
+// or as files in MountPath if set.
+type Binding struct {
+	// Name of the binding, defaults to its index.
+	Name      string `json:"name,omitempty"`
+	Source    Source `json:"source"`
+	EnvPrefix string `json:"envPrefix,omitempty"`
+	MountPath string `json:"mountPath,omitempty"`
+}
+
+// Source of bound values, either a Secret or ConfigMap, or fields of other components' output.
+type Source struct {
+	ObjectRef *corev1.ObjectReference `json:"objectRef,omitempty"`
+	// Values read from other components, named by Name and read From a component field.
+	Values []v1alpha1.ParameterValue `json:"values,omitempty"`
+}
+
+// Resolved is a binding with the values of its source.
+type Resolved struct {
+	Binding
+	// Name of the Secret or ConfigMap holding values, empty for values read from components.
+	SecretName, ConfigMapName string
+	Values                    map[string]string
+}
+
+// ParseParameter reads the service binding trait properties of comp, ok is false if comp has no service binding trait.
+func ParseParameter(comp *v1alpha1.ComponentConfiguration) (param *Parameter, ok bool, err error) {
+	for _, t := range comp.Traits {
+		if t.Name != TraitName {
+			continue
+		}
+		param = &Parameter{}
+		if len(t.Properties.Raw) > 0 {
+			if err := json.Unmarshal(t.Properties.Raw, param); err != nil {
+				return nil, false, fmt.Errorf("invalid %s trait properties: %v", TraitName, err)
+			}
+		}
+		for i := range param.ServiceBindings {
+			if param.ServiceBindings[i].Name == "" {
+				param.ServiceBindings[i].Name = fmt.Sprintf("%s-%d", TraitName, i)
+			}
+		}
+		return param, true, nil
+	}
+	return nil, false, nil
+}
+
+// Dependencies returns names of the components the bindings of trait t read values from.
+func Dependencies(t *v1alpha1.TraitBinding) []string {
+	param, ok, err := ParseParameter(&v1alpha1.ComponentConfiguration{Traits: []v1alpha1.TraitBinding{*t}})
+	if err != nil || !ok {
+		return nil
+	}
+	var values []v1alpha1.ParameterValue
+	for _, b := range param.ServiceBindings {
+		values = append(values, b.Source.Values...)
+	}
+	return (&v1alpha1.ComponentConfiguration{ParameterValues: values}).ParameterDependencies()
+}
+
+func init() {
+	v1alpha1.RegisterTraitDependencies(TraitName, Dependencies)
+}
+
+// Resolve reads the values of bindings, objects are looked up in the namespace of ac and values
+// of components are resolved as parameter values, a oam.PendingError is returned if they are not available yet.
+func Resolve(ctx context.Context, c client.Reader, ac *v1alpha1.ApplicationConfiguration, bindings []Binding) ([]Resolved, error) {
+	var resolved []Resolved
+	for _, b := range bindings {
+		r := Resolved{Binding: b, Values: map[string]string{}}
+		ref := b.Source.ObjectRef
+		switch {
+		case ref != nil:
+			if ref.Namespace != "" && ref.Namespace != ac.Namespace {
+				return nil, fmt.Errorf("binding %s: %s %s must be in namespace %s", b.Name, ref.Kind, ref.Name, ac.Namespace)
+			}
+			key := types.NamespacedName{Namespace: ac.Namespace, Name: ref.Name}
+			switch ref.Kind {
+			case "Secret":
+				secret := &corev1.Secret{}
+				if err := c.Get(ctx, key, secret); err != nil {
+					return nil, err
+				}
+				for k, v := range secret.Data {
+					r.Values[k] = string(v)
+				}
+				r.SecretName = ref.Name
+			case "ConfigMap":
+				cm := &corev1.ConfigMap{}
+				if err := c.Get(ctx, key, cm); err != nil {
+					return nil, err
+				}
+				for k, v := range cm.Data {
+					r.Values[k] = v
+				}
+				for k, v := range cm.BinaryData {
+					r.Values[k] = string(v)
+				}
+				r.ConfigMapName = ref.Name
+			default:
+				return nil, fmt.Errorf("binding %s: unsupported kind %q, Secret or ConfigMap expected", b.Name, ref.Kind)
+			}
+		case len(b.Source.Values) > 0:
+			values, err := oam.ResolveParameters(ctx, c, ac, &v1alpha1.ComponentConfiguration{ParameterValues: b.Source.Values})
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range values {
+				r.Values[v.Name] = v.Value
+			}
+		default:
+			return nil, fmt.Errorf("binding %s has no source", b.Name)
+		}
+		resolved = append(resolved, r)
+	}
+	return resolved, nil
+}
+
+// SecretName returns the name of the Secret generated for values of binding b read from components,
+// to be mounted as files.
+func SecretName(ac *v1alpha1.ApplicationConfiguration, comp *v1alpha1.ComponentConfiguration, b Binding) string {
+	return ac.Name + "-" + comp.InstanceName + "-" + b.Name
+}
+
+// Project projects resolved values into every container of template and records their checksum.
+// Values of Secrets and ConfigMaps are referenced, values read from components are set as env vars,
+// or mounted from the Secret named SecretName.
+func Project(template *corev1.PodTemplateSpec, ac *v1alpha1.ApplicationConfiguration,
+	comp *v1alpha1.ComponentConfiguration, resolved []Resolved) {
+	for _, r := range resolved {
+		if r.MountPath != "" {
+			volume := corev1.Volume{Name: r.Name}
+			switch {
+			case r.ConfigMapName != "":
+				volume.ConfigMap = &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: r.ConfigMapName}}
+			case r.SecretName != "":
+				volume.Secret = &corev1.SecretVolumeSource{SecretName: r.SecretName}
+			default:
+				volume.Secret = &corev1.SecretVolumeSource{SecretName: SecretName(ac, comp, r.Binding)}
+			}
+			template.Spec.Volumes = append(template.Spec.Volumes, volume)
+		}
+		for i := range template.Spec.Containers {
+			container := &template.Spec.Containers[i]
+			switch {
+			case r.MountPath != "":
+				container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: r.Name, MountPath: r.MountPath, ReadOnly: true})
+			case r.ConfigMapName != "":
+				container.EnvFrom = append(container.EnvFrom, corev1.EnvFromSource{
+					Prefix:       r.EnvPrefix,
+					ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: r.ConfigMapName}},
+				})
+			case r.SecretName != "":
+				container.EnvFrom = append(container.EnvFrom, corev1.EnvFromSource{
+					Prefix:    r.EnvPrefix,
+					SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: r.SecretName}},
+				})
+			default:
+				for _, k := range sortedKeys(r.Values) {
+					container.Env = append(container.Env, corev1.EnvVar{Name: r.EnvPrefix + k, Value: r.Values[k]})
+				}
+			}
+		}
+	}
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[ChecksumAnnotation] = Checksum(resolved)
+}
+
+// Checksum returns the checksum of resolved values.
+func Checksum(resolved []Resolved) string {
+	h := sha256.New()
+	for _, r := range resolved {
+		fmt.Fprintf(h, "%s\n", r.Name)
+		for _, k := range sortedKeys(r.Values) {
+			fmt.Fprintf(h, "%s=%s\n", k, r.Values[k])
+		}
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// Handle resolves the service bindings of comp and projects them into template, the pod template
+// of the workload a handler built for comp. Secrets holding values read from components to be mounted
+// as files are created or updated through ctx. Nothing is done if comp has no service binding trait.
+func Handle(ctx *oam.ActionContext, c client.Reader, ac *v1alpha1.ApplicationConfiguration,
+	comp *v1alpha1.ComponentConfiguration, template *corev1.PodTemplateSpec) error {
+	param, ok, err := ParseParameter(comp)
+	if err != nil || !ok {
+		return err
+	}
+	resolved, err := Resolve(context.Background(), c, ac, param.ServiceBindings)
+	if err != nil {
+		return err
+	}
+	for _, r := range resolved {
+		if r.MountPath == "" || r.SecretName != "" || r.ConfigMapName != "" {
+			continue
+		}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      SecretName(ac, comp, r.Binding),
+				Namespace: ac.Namespace,
+				Labels: map[string]string{
+					v1alpha1.LabelApplicationConfiguration: ac.Name,
+					v1alpha1.LabelComponent:                comp.ComponentName,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(ac, v1alpha1.SchemeGroupVersion.WithKind("ApplicationConfiguration")),
+				},
+			},
+			// set as the api server defaults it, for the Secret to compare equal to the live one
+			Type: corev1.SecretTypeOpaque,
+			Data: secretData(r.Values),
+		}
+		existing := &corev1.Secret{}
+		err := c.Get(context.Background(), types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}, existing)
+		switch {
+		case client.IgnoreNotFound(err) != nil:
+			return err
+		case err != nil:
+			ctx.Add(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeCreate, Plan: secret})
+		default:
+			secret.ResourceVersion = existing.ResourceVersion
+			ctx.Add(oam.Action{Provider: oam.PTypeK8S, Command: oam.CmdTypeUpdate, Plan: secret})
+		}
+	}
+	Project(template, ac, comp, resolved)
+	return nil
+}
+
+// Watch indexes ApplicationConfigurations of mgr by IndexBindings and registers watches of Secrets and
+// ConfigMaps for the ApplicationConfiguration reconciler of rt, an ApplicationConfiguration is reconciled
+// again when an object it binds changes.
+func Watch(rt *oam.Runtime, mgr manager.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(&v1alpha1.ApplicationConfiguration{}, IndexBindings, indexBindings); err != nil {
+		return err
+	}
+	h := EnqueueBindingApplications(mgr.GetClient())
+	rt.Watches(oam.STypeApplicationConfiguration, &source.Kind{Type: &corev1.Secret{}}, h)
+	rt.Watches(oam.STypeApplicationConfiguration, &source.Kind{Type: &corev1.ConfigMap{}}, h)
+	return nil
+}
+
+// indexBindings returns the <kind>/<name> of the objects bound by components of an ApplicationConfiguration.
+func indexBindings(obj runtime.Object) []string {
+	ac, ok := obj.(*v1alpha1.ApplicationConfiguration)
+	if !ok {
+		return nil
+	}
+	var refs []string
+	seen := map[string]bool{}
+	for i := range ac.Spec.Components {
+		param, ok, err := ParseParameter(&ac.Spec.Components[i])
+		if err != nil || !ok {
+			continue
+		}
+		for _, b := range param.ServiceBindings {
+			if ref := b.Source.ObjectRef; ref != nil && !seen[ref.Kind+"/"+ref.Name] {
+				seen[ref.Kind+"/"+ref.Name] = true
+				refs = append(refs, ref.Kind+"/"+ref.Name)
+			}
+		}
+	}
+	return refs
+}
+
+// EnqueueBindingApplications maps a Secret or ConfigMap to the ApplicationConfigurations of its
+// namespace binding it, listed through the IndexBindings field index.
+func EnqueueBindingApplications(c client.Reader) handler.EventHandler {
+	return &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
+		var kind string
+		switch a.Object.(type) {
+		case *corev1.Secret:
+			kind = "Secret"
+		case *corev1.ConfigMap:
+			kind = "ConfigMap"
+		default:
+			return nil
+		}
+		list := &v1alpha1.ApplicationConfigurationList{}
+		if err := c.List(context.Background(), list, client.InNamespace(a.Meta.GetNamespace()),
+			client.MatchingField(IndexBindings, kind+"/"+a.Meta.GetName())); err != nil {
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, ac := range list.Items {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: ac.Namespace, Name: ac.Name},
+			})
+		}
+		return requests
+	})}
+}
+
+// secretData returns values as Secret data, StringData is only written to the api server and
+// wouldn't compare equal to the live Secret.
+func secretData(values map[string]string) map[string][]byte {
+	data := make(map[string][]byte, len(values))
+	for k, v := range values {
+		data[k] = []byte(v)
+	}
+	return data
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
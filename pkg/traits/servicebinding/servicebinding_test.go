@@ -0,0 +1,155 @@
+package servicebinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/oam-dev/oam-go-sdk/apis/core.oam.dev/v1alpha1"
+	"github.com/oam-dev/oam-go-sdk/pkg/oam"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+func newScheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+	_ = scheme.AddToScheme(s)
+	_ = v1alpha1.AddToScheme(s)
+	return s
+}
+
+// indexedReader lists ApplicationConfigurations matching field selectors with indexBindings, as the
+// manager cache does.
+type indexedReader struct {
+	client.Reader
+	items []v1alpha1.ApplicationConfiguration
+}
+
+func (r *indexedReader) List(ctx context.Context, list runtime.Object, opts ...client.ListOption) error {
+	o := &client.ListOptions{}
+	o.ApplyOptions(opts)
+	for _, ac := range r.items {
+		if ac.Namespace != o.Namespace {
+			continue
+		}
+		for _, ref := range indexBindings(&ac) {
+			if value, _ := o.FieldSelector.RequiresExactMatch(IndexBindings); value == ref {
+				l := list.(*v1alpha1.ApplicationConfigurationList)
+				l.Items = append(l.Items, ac)
+			}
+		}
+	}
+	return nil
+}
+
+func newApp(properties string) *v1alpha1.ApplicationConfiguration {
+	return &v1alpha1.ApplicationConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+		Spec: v1alpha1.ApplicationConfigurationSpec{
+			Components: []v1alpha1.ComponentConfiguration{{
+				ComponentName: "web",
+				InstanceName:  "web",
+				Traits: []v1alpha1.TraitBinding{{
+					Name:       TraitName,
+					Properties: runtime.RawExtension{Raw: []byte(properties)},
+				}},
+			}},
+		},
+	}
+}
+
+func newTemplate() *corev1.PodTemplateSpec {
+	return &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: "nginx"}}},
+	}
+}
+
+func TestHandle(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-config", Namespace: "default"},
+		Data:       map[string]string{"config.yaml": "debug: true"},
+	}
+	ac := newApp(`{"servicebindings": [
+		{"source": {"objectRef": {"apiVersion": "v1", "kind": "Secret", "name": "my-secret"}}, "envPrefix": "DB_"},
+		{"name": "config", "source": {"objectRef": {"apiVersion": "v1", "kind": "ConfigMap", "name": "my-config"}}, "mountPath": "/etc/web"}
+	]}`)
+	c := fake.NewFakeClientWithScheme(newScheme(), secret, cm, ac)
+
+	ctx := &oam.ActionContext{}
+	template := newTemplate()
+	assert.NoError(t, Handle(ctx, c, ac, &ac.Spec.Components[0], template))
+	assert.Empty(t, ctx.Gather())
+	container := template.Spec.Containers[0]
+	assert.Equal(t, []corev1.EnvFromSource{{
+		Prefix:    "DB_",
+		SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"}},
+	}}, container.EnvFrom)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "config", MountPath: "/etc/web", ReadOnly: true}}, container.VolumeMounts)
+	assert.Equal(t, "my-config", template.Spec.Volumes[0].ConfigMap.Name)
+	checksum := template.Annotations[ChecksumAnnotation]
+	assert.NotEmpty(t, checksum)
+
+	// pods are rolled when the secret changes
+	secret.Data["password"] = []byte("changed")
+	resolved, err := Resolve(context.Background(), fake.NewFakeClientWithScheme(newScheme(), secret, cm), ac, mustParse(t, ac).ServiceBindings)
+	assert.NoError(t, err)
+	assert.NotEqual(t, checksum, Checksum(resolved))
+
+	// objects of the bindings trigger the app
+	var enqueued []string
+	assert.Equal(t, []string{"Secret/my-secret", "ConfigMap/my-config"}, indexBindings(ac))
+	mapper := EnqueueBindingApplications(&indexedReader{items: []v1alpha1.ApplicationConfiguration{*ac}}).(*handler.EnqueueRequestsFromMapFunc).ToRequests
+	for _, r := range mapper.Map(handler.MapObject{Meta: secret, Object: secret}) {
+		enqueued = append(enqueued, r.Name)
+	}
+	assert.Equal(t, []string{"app"}, enqueued)
+	other := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}}
+	assert.Empty(t, mapper.Map(handler.MapObject{Meta: other, Object: other}))
+}
+
+func TestHandleComponentValues(t *testing.T) {
+	ac := newApp(`{"servicebindings": [
+		{"name": "db", "source": {"values": [{"name": "host", "from": {"component": "db", "fieldPath": ".spec.clusterIP"}}]}, "envPrefix": "DB_"},
+		{"name": "files", "source": {"values": [{"name": "host", "from": {"component": "db", "fieldPath": ".spec.clusterIP"}}]}, "mountPath": "/etc/db"}
+	]}`)
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.0.0.1"},
+	}
+	ac.Status.Modules = []v1alpha1.ModuleStatus{{
+		NamespacedName: "default/db", Kind: "Service", GroupVersion: "v1", Component: "db",
+	}}
+	c := fake.NewFakeClientWithScheme(newScheme(), svc)
+
+	ctx := &oam.ActionContext{}
+	template := newTemplate()
+	assert.NoError(t, Handle(ctx, c, ac, &ac.Spec.Components[0], template))
+	assert.Equal(t, []corev1.EnvVar{{Name: "DB_host", Value: "10.0.0.1"}}, template.Spec.Containers[0].Env)
+	assert.Equal(t, "app-web-files", template.Spec.Volumes[0].Secret.SecretName)
+	actions := ctx.Gather()
+	assert.Len(t, actions, 1)
+	assert.Equal(t, oam.CmdTypeCreate, actions[0].Command)
+	assert.Equal(t, map[string][]byte{"host": []byte("10.0.0.1")}, actions[0].Plan.(*corev1.Secret).Data)
+
+	// the source component is a dependency, blocked until ready; values are pending until it produced them
+	assert.Equal(t, []string{"db"}, ac.Spec.Components[0].Dependencies())
+	ac.Status.Modules = nil
+	err := Handle(ctx, c, ac, &ac.Spec.Components[0], newTemplate())
+	assert.True(t, oam.IsPending(err))
+}
+
+func mustParse(t *testing.T, ac *v1alpha1.ApplicationConfiguration) *Parameter {
+	param, ok, err := ParseParameter(&ac.Spec.Components[0])
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	return param
+}